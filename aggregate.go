@@ -0,0 +1,138 @@
+package goen
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// aggregateTimeLayouts are text formats of times which drivers return for expressions; e.g. MIN(created_at) on sqlite3.
+var aggregateTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// AggregateScanner makes a scanner for an aggregated value into dest, NULL leaves dest as is.
+// dest is a pointer to a numeric, string or time.Time value, or a pointer to them.
+// Drivers may lose column types of aggregated values, then texts are parsed; e.g. DECIMAL on mysql.
+func AggregateScanner(dest interface{}) sql.Scanner {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		panic(fmt.Sprintf("goen: AggregateScanner only accepts a pointer, not %T", dest))
+	}
+	return &aggregateScanner{rv.Elem()}
+}
+
+type aggregateScanner struct {
+	dest reflect.Value
+}
+
+func (s *aggregateScanner) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	rv := s.dest
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+	if rv.Type() == timeType {
+		switch v := src.(type) {
+		case time.Time:
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		case string:
+			for _, layout := range aggregateTimeLayouts {
+				if t, err := time.Parse(layout, v); err == nil {
+					rv.Set(reflect.ValueOf(t))
+					return nil
+				}
+			}
+			return fmt.Errorf("goen: unable to parse %q as time", v)
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := src.(type) {
+		case int64:
+			rv.SetInt(v)
+			return nil
+		case float64:
+			rv.SetInt(int64(v))
+			return nil
+		case string:
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				rv.SetInt(i)
+				return nil
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("goen: unable to parse %q as %v", v, rv.Type())
+			}
+			rv.SetInt(int64(f))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch v := src.(type) {
+		case int64:
+			rv.SetUint(uint64(v))
+			return nil
+		case float64:
+			rv.SetUint(uint64(v))
+			return nil
+		case string:
+			if u, err := strconv.ParseUint(v, 10, 64); err == nil {
+				rv.SetUint(u)
+				return nil
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("goen: unable to parse %q as %v", v, rv.Type())
+			}
+			rv.SetUint(uint64(f))
+			return nil
+		}
+	case reflect.String:
+		switch v := src.(type) {
+		case string:
+			rv.SetString(v)
+			return nil
+		case int64, float64:
+			rv.SetString(fmt.Sprint(v))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch v := src.(type) {
+		case int64:
+			rv.SetFloat(float64(v))
+			return nil
+		case float64:
+			rv.SetFloat(v)
+			return nil
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("goen: unable to parse %q as %v", v, rv.Type())
+			}
+			rv.SetFloat(f)
+			return nil
+		}
+	}
+	return fmt.Errorf("goen: unable to scan %T as %v", src, rv.Type())
+}
@@ -0,0 +1,60 @@
+package goen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateScanner(t *testing.T) {
+	assert.Panics(t, func() {
+		AggregateScanner(0)
+	})
+
+	var i int
+	assert.NoError(t, AggregateScanner(&i).Scan(int64(3)))
+	assert.Equal(t, 3, i)
+	assert.NoError(t, AggregateScanner(&i).Scan([]byte("12.0000")))
+	assert.Equal(t, 12, i)
+	assert.NoError(t, AggregateScanner(&i).Scan(nil))
+	assert.Equal(t, 12, i, "NULL leaves dest as is")
+	assert.Error(t, AggregateScanner(&i).Scan("x"))
+
+	var u uint64
+	assert.NoError(t, AggregateScanner(&u).Scan("7"))
+	assert.Equal(t, uint64(7), u)
+
+	var f float64
+	assert.NoError(t, AggregateScanner(&f).Scan(int64(2)))
+	assert.Equal(t, 2.0, f)
+	assert.NoError(t, AggregateScanner(&f).Scan("1.5"))
+	assert.Equal(t, 1.5, f)
+
+	var s string
+	assert.NoError(t, AggregateScanner(&s).Scan([]byte("abc")))
+	assert.Equal(t, "abc", s)
+	assert.NoError(t, AggregateScanner(&s).Scan(int64(1)))
+	assert.Equal(t, "1", s)
+
+	var p *int
+	assert.NoError(t, AggregateScanner(&p).Scan(nil))
+	assert.Nil(t, p)
+	assert.NoError(t, AggregateScanner(&p).Scan(int64(5)))
+	if assert.NotNil(t, p) {
+		assert.Equal(t, 5, *p)
+	}
+
+	want := time.Date(2018, 8, 9, 13, 48, 29, 0, time.UTC)
+	var tm time.Time
+	assert.NoError(t, AggregateScanner(&tm).Scan(want))
+	assert.Equal(t, want, tm)
+	for _, s := range []string{"2018-08-09 13:48:29+00:00", "2018-08-09T13:48:29Z", "2018-08-09 13:48:29"} {
+		tm = time.Time{}
+		if assert.NoError(t, AggregateScanner(&tm).Scan(s)) {
+			assert.True(t, want.Equal(tm), "parses %q", s)
+		}
+	}
+	assert.Error(t, AggregateScanner(&tm).Scan("yesterday"))
+	assert.Error(t, AggregateScanner(&tm).Scan(int64(1)))
+}
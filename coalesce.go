@@ -0,0 +1,205 @@
+package goen
+
+// CompilerWithCoalesce returns PatchCompiler that coalesces patches by CoalescePatches before compiling by c.
+// When meta is nil, an empty MetaSchema is used for identifying rows.
+func CompilerWithCoalesce(c PatchCompiler, meta MetaSchema) PatchCompiler {
+	if meta == nil {
+		meta = NewMetaSchema()
+	}
+	return PatchCompilerFunc(func(opts *CompilerOptions) *SqlizerList {
+		coalesced := *opts
+		coalesced.Patches = CoalescePatches(meta, opts.Patches)
+		return c.Compile(&coalesced)
+	})
+}
+
+// CoalescePatches returns new PatchList that merges patches targeting the same row.
+// Rows are identified by KeyStringFromRowKey of meta.
+//
+//   - update patches for a row are merged into one update patch with the last values
+//   - update patches following an insert patch are folded into that insert patch
+//   - an insert patch and a following delete patch for a row are dropped entirely
+//
+// A merged update patch takes the place of the last one, a folded insert patch keeps its place.
+// The relative order of other patches is preserved.
+// Patches without row key (e.g. update all rows) or changing the row key stop coalescing on that table.
+// Patches with Where conditions stop coalescing on all tables, since the conditions may refer other tables.
+// A patch on another table stops coalescing on all tables too, since moving patches across it may break
+// foreign keys; e.g. an update referring a row inserted in between.
+// Given patches are never modified.
+func CoalescePatches(meta MetaSchema, patches *PatchList) *PatchList {
+	c := &patchCoalescer{
+		meta:    meta,
+		out:     NewPatchList(),
+		rows:    map[string]map[string]*PatchElement{},
+		inserts: map[string][]*PatchElement{},
+	}
+	for curr := patches.Front(); curr != nil; curr = curr.Next() {
+		c.add(curr.GetValue())
+	}
+	return c.out
+}
+
+type patchCoalescer struct {
+	meta MetaSchema
+
+	out *PatchList
+
+	// tableName is the table of the last patch, patches are coalesced only in a run on the same table.
+	tableName string
+
+	// rows holds the last element for each row key string, grouped by table name.
+	rows map[string]map[string]*PatchElement
+
+	// inserts holds insert elements which have not been identified yet, grouped by table name.
+	// since an insert patch has no RowKey, it's identified lazily by a following patch.
+	inserts map[string][]*PatchElement
+}
+
+func (c *patchCoalescer) add(patch *Patch) {
+	if patch.TableName != c.tableName {
+		c.forgetAll()
+		c.tableName = patch.TableName
+	}
+	if patch.Err != nil {
+		// reported by the compiler as it is
+		c.forget(patch.TableName)
+		c.out.PushBack(patch)
+		return
+	}
+	if patch.Where != nil {
+		// subqueries of the conditions may refer any tables
+		c.forgetAll()
+	}
+	switch patch.Kind {
+	case PatchInsert:
+		e := c.out.PushBack(patch)
+		c.inserts[patch.TableName] = append(c.inserts[patch.TableName], e)
+	case PatchUpdate:
+		if !hasRowKey(patch.RowKey) || changesRowKey(patch) {
+			c.forget(patch.TableName)
+			c.out.PushBack(patch)
+			return
+		}
+		key := c.meta.KeyStringFromRowKey(patch.RowKey)
+		prev := c.lookup(patch.TableName, key, patch.RowKey)
+		switch {
+		case prev == nil:
+			c.track(patch.TableName, key, c.out.PushBack(patch))
+		case prev.GetValue().Kind == PatchInsert:
+			prev.SetValue(mergePatch(prev.GetValue(), patch))
+		default:
+			merged := mergePatch(prev.GetValue(), patch)
+			c.out.Remove(prev)
+			c.track(patch.TableName, key, c.out.PushBack(merged))
+		}
+	case PatchDelete:
+		if !hasRowKey(patch.RowKey) {
+			c.forget(patch.TableName)
+			c.out.PushBack(patch)
+			return
+		}
+		key := c.meta.KeyStringFromRowKey(patch.RowKey)
+		prev := c.lookup(patch.TableName, key, patch.RowKey)
+		delete(c.rows[patch.TableName], key)
+		if prev != nil && prev.GetValue().Kind == PatchInsert {
+			// never been there
+			c.out.Remove(prev)
+			return
+		}
+		c.out.PushBack(patch)
+	default:
+		// unknown kind may affect any rows in the table
+		c.forget(patch.TableName)
+		c.out.PushBack(patch)
+	}
+}
+
+func (c *patchCoalescer) track(tableName string, key string, e *PatchElement) {
+	if _, ok := c.rows[tableName]; !ok {
+		c.rows[tableName] = map[string]*PatchElement{}
+	}
+	c.rows[tableName][key] = e
+}
+
+func (c *patchCoalescer) forget(tableName string) {
+	delete(c.rows, tableName)
+	delete(c.inserts, tableName)
+}
+
+func (c *patchCoalescer) forgetAll() {
+	c.rows = map[string]map[string]*PatchElement{}
+	c.inserts = map[string][]*PatchElement{}
+}
+
+// lookup finds the last element for given row key.
+// an insert element is identified by its values for rowKey's columns.
+func (c *patchCoalescer) lookup(tableName string, key string, rowKey RowKey) *PatchElement {
+	if e, ok := c.rows[tableName][key]; ok {
+		return e
+	}
+	cols, _ := rowKey.RowKey()
+	inserts := c.inserts[tableName]
+	for i, e := range inserts {
+		insertKey := &MapRowKey{
+			Table: rowKey.TableName(),
+			Key:   map[string]interface{}{},
+		}
+		patch := e.GetValue()
+		for _, col := range cols {
+			if idx := indexOfString(patch.Columns, col); idx >= 0 {
+				insertKey.Key[col] = patch.Values[idx]
+			}
+		}
+		if len(insertKey.Key) != len(cols) || c.meta.KeyStringFromRowKey(insertKey) != key {
+			continue
+		}
+		c.inserts[tableName] = append(inserts[:i:i], inserts[i+1:]...)
+		c.track(tableName, key, e)
+		return e
+	}
+	return nil
+}
+
+func hasRowKey(rowKey RowKey) bool {
+	if rowKey == nil {
+		return false
+	}
+	cols, _ := rowKey.RowKey()
+	return len(cols) > 0
+}
+
+func changesRowKey(patch *Patch) bool {
+	cols, _ := patch.RowKey.RowKey()
+	for _, col := range cols {
+		if indexOfString(patch.Columns, col) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mergePatch returns a copy of dst that overwritten by src's columns and values.
+func mergePatch(dst *Patch, src *Patch) *Patch {
+	merged := *dst
+	merged.Columns = append([]string{}, dst.Columns...)
+	merged.Values = append([]interface{}{}, dst.Values...)
+	for i, col := range src.Columns {
+		if idx := indexOfString(merged.Columns, col); idx >= 0 {
+			merged.Values[idx] = src.Values[i]
+		} else {
+			merged.Columns = append(merged.Columns, col)
+			merged.Values = append(merged.Values, src.Values[i])
+		}
+	}
+	return &merged
+}
+
+func indexOfString(l []string, s string) int {
+	for i := range l {
+		if l[i] == s {
+			return i
+		}
+	}
+	return -1
+}
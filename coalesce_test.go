@@ -0,0 +1,192 @@
+package goen
+
+import (
+	"testing"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalescePatches(t *testing.T) {
+	rowKey := func(id int) RowKey {
+		return &MapRowKey{
+			Table: "testing",
+			Key: map[string]interface{}{
+				"id": id,
+			},
+		}
+	}
+	postKey := func(id int) RowKey {
+		return &MapRowKey{
+			Table: "post",
+			Key: map[string]interface{}{
+				"id": id,
+			},
+		}
+	}
+	cases := []struct {
+		Name    string
+		Patches []*Patch
+		Expect  []*Patch
+	}{
+		{
+			"merges updates into the last one",
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"x"}, rowKey(2)),
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"b", "memo"}, rowKey(1)),
+			},
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"x"}, rowKey(2)),
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"b", "memo"}, rowKey(1)),
+			},
+		},
+		{
+			"folds updates into the insert",
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{2, "b"}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"c"}, rowKey(1)),
+				UpdatePatch("testing", []string{"memo"}, []interface{}{"memo"}, rowKey(1)),
+			},
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name", "memo"}, []interface{}{1, "c", "memo"}),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{2, "b"}),
+			},
+		},
+		{
+			"drops insert and delete pair",
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{2, "b"}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"c"}, rowKey(1)),
+				DeletePatch("testing", rowKey(1)),
+			},
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{2, "b"}),
+			},
+		},
+		{
+			"keeps update and delete",
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				DeletePatch("testing", rowKey(1)),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "b"}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"c"}, rowKey(1)),
+			},
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				DeletePatch("testing", rowKey(1)),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "c"}),
+			},
+		},
+		{
+			"stops coalescing by patches without row key",
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, nil),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"c"}, rowKey(1)),
+			},
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, nil),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"c"}, rowKey(1)),
+			},
+		},
+		{
+			"stops coalescing by patches changing row key",
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				UpdatePatch("testing", []string{"id"}, []interface{}{2}, rowKey(1)),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				UpdatePatch("testing", []string{"id"}, []interface{}{2}, rowKey(1)),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+		},
+		{
+			"stops coalescing by patches on other tables",
+			[]*Patch{
+				InsertPatch("post", []string{"id", "blog_id"}, []interface{}{1, nil}),
+				InsertPatch("blog", []string{"id"}, []interface{}{2}),
+				UpdatePatch("post", []string{"blog_id"}, []interface{}{2}, postKey(1)),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				DeletePatch("blog", &MapRowKey{Table: "blog", Key: map[string]interface{}{"id": 3}}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+			[]*Patch{
+				InsertPatch("post", []string{"id", "blog_id"}, []interface{}{1, nil}),
+				InsertPatch("blog", []string{"id"}, []interface{}{2}),
+				UpdatePatch("post", []string{"blog_id"}, []interface{}{2}, postKey(1)),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				DeletePatch("blog", &MapRowKey{Table: "blog", Key: map[string]interface{}{"id": 3}}),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+		},
+		{
+			"stops coalescing on all tables by patches with conditions",
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				UpdateAllPatch("other", []string{"memo"}, []interface{}{"x"}, sqr.Expr(`"id" IN (SELECT "id" FROM "testing" WHERE "name" = ?)`, "a")),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+			[]*Patch{
+				UpdatePatch("testing", []string{"name"}, []interface{}{"a"}, rowKey(1)),
+				UpdateAllPatch("other", []string{"memo"}, []interface{}{"x"}, sqr.Expr(`"id" IN (SELECT "id" FROM "testing" WHERE "name" = ?)`, "a")),
+				UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, rowKey(1)),
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			patches := NewPatchList()
+			for _, patch := range c.Patches {
+				patches.PushBack(patch)
+			}
+			original := make([]Patch, len(c.Patches))
+			for i := range c.Patches {
+				original[i] = *c.Patches[i]
+			}
+
+			coalesced := CoalescePatches(NewMetaSchema(), patches)
+			var actual []*Patch
+			for curr := coalesced.Front(); curr != nil; curr = curr.Next() {
+				actual = append(actual, curr.GetValue())
+			}
+			assert.Equal(t, c.Expect, actual)
+
+			for i := range c.Patches {
+				assert.Equal(t, original[i], *c.Patches[i], "never modifies given patches")
+			}
+		})
+	}
+}
+
+func TestCompilerWithCoalesce(t *testing.T) {
+	patches := NewPatchList()
+	patches.PushBack(InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}))
+	patches.PushBack(UpdatePatch("testing", []string{"name"}, []interface{}{"b"}, &MapRowKey{
+		Table: "testing",
+		Key: map[string]interface{}{
+			"id": 1,
+		},
+	}))
+	compiler := CompilerWithCoalesce(DefaultCompiler, nil)
+	sqlizers := compiler.Compile(&CompilerOptions{
+		Dialect: &testingDialect{},
+		Patches: patches,
+	})
+	if !assert.Equal(t, 1, sqlizers.Len()) {
+		return
+	}
+	query, args, err := sqlizers.Front().GetValue().ToSql()
+	if !assert.NoError(t, err) {
+		return
+	}
+	expectQuery, expectArgs, _ := sqr.Expr(`INSERT INTO "testing" ("id","name") VALUES (?,?)`, 1, "b").ToSql()
+	assert.Equal(t, expectQuery, query)
+	assert.Equal(t, expectArgs, args)
+	assert.Equal(t, 2, patches.Len(), "never modifies given patches")
+}
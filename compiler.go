@@ -1,7 +1,10 @@
 package goen
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"strconv"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen/dialect"
@@ -69,6 +72,51 @@ func (opts *compilerOptionsUtils) Quotes(l []string) []string {
 	return out
 }
 
+// MaxBindParameters returns the maximum number of bind parameters for the dialect; or 0 if unlimited.
+func (opts *compilerOptionsUtils) MaxBindParameters() int {
+	if limiter, ok := opts.Dialect.(dialect.BindParameterLimiter); ok {
+		return limiter.MaxBindParameters()
+	}
+	return 0
+}
+
+// UpsertClause renders a clause following "INSERT ... VALUES (...)" for an upsert patch.
+func (opts *compilerOptionsUtils) UpsertClause(patch *Patch) (string, error) {
+	capabilities, ok := opts.Dialect.(dialect.Capabilities)
+	if !ok {
+		return "", errors.New("goen: dialect does not support upsert")
+	}
+	var conflictColumns []string
+	if patch.RowKey != nil {
+		conflictColumns, _ = patch.RowKey.RowKey()
+	}
+	var updateColumns []string
+	for _, col := range patch.Columns {
+		if indexOfString(conflictColumns, col) < 0 {
+			updateColumns = append(updateColumns, col)
+		}
+	}
+	var (
+		clause string
+		err    error
+	)
+	if upserter, ok := capabilities.(dialect.LastInsertIDUpserter); ok && patch.AutoIncrementColumn != "" {
+		clause, err = upserter.LastInsertIDUpsertClause(conflictColumns, updateColumns, patch.AutoIncrementColumn)
+	} else {
+		clause, err = capabilities.UpsertClause(conflictColumns, updateColumns)
+	}
+	return clause, err
+}
+
+// errorSqlizer reports an error found while compiling a patch, then SaveChanges returns it.
+type errorSqlizer struct {
+	err error
+}
+
+func (s *errorSqlizer) ToSql() (string, []interface{}, error) {
+	return "", nil, s.err
+}
+
 func (opts *compilerOptionsUtils) RowKeyToSqlizer(rowKey RowKey) sqr.Sqlizer {
 	if rowKey == nil {
 		// always true
@@ -105,6 +153,40 @@ func (opts *compilerOptionsUtils) PostDeleteBuilder(stmt sqr.DeleteBuilder) sqr.
 	}
 }
 
+// WithResult wraps a compiled sqlizer to fill patch.Result by SaveChanges, or returns it as it is if patch.Result is nil.
+func (opts *compilerOptionsUtils) WithResult(patch *Patch, sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	if patch.Result == nil {
+		return sqlizer
+	}
+	return &resultSqlizer{sqlizer, patch.Result}
+}
+
+type resultSqlizer struct {
+	sqr.Sqlizer
+
+	result *PatchResult
+}
+
+func (stmt *resultSqlizer) ExecContext(ctx context.Context, dbc *DBContext) error {
+	if execer, ok := stmt.Sqlizer.(SqlizerExecer); ok {
+		return execer.ExecContext(ctx, dbc)
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return err
+	}
+	res, err := dbc.QueryRunner.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	stmt.result.RowsAffected = n
+	return nil
+}
+
 type defaultCompiler struct{}
 
 func (*defaultCompiler) Compile(options *CompilerOptions) (sqlizers *SqlizerList) {
@@ -116,6 +198,10 @@ func (*defaultCompiler) Compile(options *CompilerOptions) (sqlizers *SqlizerList
 		if len(patch.Columns) != len(patch.Values) {
 			panic("goen: number of columns and values are mismatched")
 		}
+		if patch.Err != nil {
+			sqlizers.PushBack(&errorSqlizer{patch.Err})
+			continue
+		}
 		switch patch.Kind {
 		case PatchInsert:
 			stmt := stmtBuilder.Insert(opts.Quote(patch.TableName)).
@@ -133,8 +219,34 @@ func (*defaultCompiler) Compile(options *CompilerOptions) (sqlizers *SqlizerList
 			stmt := stmtBuilder.Delete(opts.Quote(patch.TableName))
 			stmt = stmt.Where(opts.RowKeyToSqlizer(patch.RowKey))
 			sqlizers.PushBack(opts.PostDeleteBuilder(stmt))
+		case PatchUpsert:
+			clause, err := opts.UpsertClause(patch)
+			if err != nil {
+				sqlizers.PushBack(&errorSqlizer{err})
+				continue
+			}
+			stmt := stmtBuilder.Insert(opts.Quote(patch.TableName)).
+				Columns(opts.Quotes(patch.Columns)...).
+				Values(patch.Values...).
+				Suffix(clause)
+			sqlizers.PushBack(opts.PostInsertBuilder(stmt))
+		case PatchUpdateAll:
+			stmt := stmtBuilder.Update(opts.Quote(patch.TableName))
+			for i := range patch.Columns {
+				stmt = stmt.Set(opts.Quote(patch.Columns[i]), patch.Values[i])
+			}
+			if patch.Where != nil {
+				stmt = stmt.Where(patch.Where)
+			}
+			sqlizers.PushBack(opts.WithResult(patch, opts.PostUpdateBuilder(stmt)))
+		case PatchDeleteAll:
+			stmt := stmtBuilder.Delete(opts.Quote(patch.TableName))
+			if patch.Where != nil {
+				stmt = stmt.Where(patch.Where)
+			}
+			sqlizers.PushBack(opts.WithResult(patch, opts.PostDeleteBuilder(stmt)))
 		default:
-			panic("goen: unable to make sql statement for unknown kind (" + string(patch.Kind) + ")")
+			panic("goen: unable to make sql statement for unknown kind (" + strconv.Itoa(int(patch.Kind)) + ")")
 		}
 	}
 	return sqlizers
@@ -143,27 +255,48 @@ func (*defaultCompiler) Compile(options *CompilerOptions) (sqlizers *SqlizerList
 type BulkCompilerOptions struct {
 	// MaxPatches limits values per bulk operations.
 	// MaxPatches<=0 means unlimited.
+	// Regardless of MaxPatches, bulk operations are split by the dialect's bind parameter limit.
+	// See dialect.BindParameterLimiter.
+	// Note that upsert patches for the same row must not be in a bulk operation on some databases, e.g. postgres.
 	MaxPatches int
 }
 
 func (c *BulkCompilerOptions) Compile(options *CompilerOptions) (sqlizers *SqlizerList) {
 	opts := (*compilerOptionsUtils)(options)
 	stmtBuilder := opts.StatementBuilder()
+	maxParams := opts.MaxBindParameters()
 	sqlizers = NewSqlizerList()
 	for curr := opts.Patches.Front(); curr != nil; curr = curr.Next() {
 		patch := curr.GetValue()
 		if len(patch.Columns) != len(patch.Values) {
 			panic("goen: number of columns and values are mismatched")
 		}
+		if patch.Err != nil {
+			sqlizers.PushBack(&errorSqlizer{patch.Err})
+			continue
+		}
 
 		switch patch.Kind {
-		case PatchInsert:
+		case PatchInsert, PatchUpsert:
 			stmt := stmtBuilder.Insert(opts.Quote(patch.TableName)).Columns(opts.Quotes(patch.Columns)...).Values(patch.Values...)
 			chunks := 1
+			params := len(patch.Values)
 			for c.canTakeMoreChunks(chunks) && curr.Next() != nil && c.isCompat(patch, curr.Next().GetValue()) {
+				if !canTakeMoreParams(params+len(curr.Next().GetValue().Values), maxParams) {
+					break
+				}
 				curr = curr.Next()
 				stmt = stmt.Values(curr.GetValue().Values...)
 				chunks++
+				params += len(curr.GetValue().Values)
+			}
+			if patch.Kind == PatchUpsert {
+				clause, err := opts.UpsertClause(patch)
+				if err != nil {
+					sqlizers.PushBack(&errorSqlizer{err})
+					continue
+				}
+				stmt = stmt.Suffix(clause)
 			}
 			sqlizers.PushBack(opts.PostInsertBuilder(stmt))
 		case PatchDelete:
@@ -171,10 +304,15 @@ func (c *BulkCompilerOptions) Compile(options *CompilerOptions) (sqlizers *Sqliz
 			cond := sqr.Or{}
 			cond = append(cond, opts.RowKeyToSqlizer(patch.RowKey))
 			chunks := 1
+			params := rowKeyParams(patch.RowKey)
 			for c.canTakeMoreChunks(chunks) && curr.Next() != nil && c.isCompat(patch, curr.Next().GetValue()) {
+				if !canTakeMoreParams(params+rowKeyParams(curr.Next().GetValue().RowKey), maxParams) {
+					break
+				}
 				curr = curr.Next()
 				cond = append(cond, opts.RowKeyToSqlizer(curr.GetValue().RowKey))
 				chunks++
+				params += rowKeyParams(curr.GetValue().RowKey)
 			}
 			stmt = stmt.Where(cond)
 			sqlizers.PushBack(opts.PostDeleteBuilder(stmt))
@@ -186,10 +324,16 @@ func (c *BulkCompilerOptions) Compile(options *CompilerOptions) (sqlizers *Sqliz
 			cond := sqr.Or{}
 			cond = append(cond, opts.RowKeyToSqlizer(patch.RowKey))
 			chunks := 1
+			// set clause values are shared by all rows
+			params := len(patch.Values) + rowKeyParams(patch.RowKey)
 			for c.canTakeMoreChunks(chunks) && curr.Next() != nil && c.isCompat(patch, curr.Next().GetValue()) {
+				if !canTakeMoreParams(params+rowKeyParams(curr.Next().GetValue().RowKey), maxParams) {
+					break
+				}
 				curr = curr.Next()
 				cond = append(cond, opts.RowKeyToSqlizer(curr.GetValue().RowKey))
 				chunks++
+				params += rowKeyParams(curr.GetValue().RowKey)
 			}
 			stmt = stmt.Where(cond)
 			sqlizers.PushBack(opts.PostUpdateBuilder(stmt))
@@ -208,7 +352,7 @@ func (c *BulkCompilerOptions) Compile(options *CompilerOptions) (sqlizers *Sqliz
 }
 
 func (c *BulkCompilerOptions) isCompat(p1, p2 *Patch) bool {
-	if p1.Kind != p2.Kind {
+	if p1.Kind != p2.Kind || p2.Err != nil {
 		return false
 	}
 	if p1.TableName != p2.TableName {
@@ -231,6 +375,21 @@ func (c *BulkCompilerOptions) isCompat(p1, p2 *Patch) bool {
 		if !reflect.DeepEqual(p1.Values, p2.Values) {
 			return false
 		}
+	case PatchUpsert:
+		// conflict target and update columns must be the same
+		if p1.AutoIncrementColumn != p2.AutoIncrementColumn {
+			return false
+		}
+		var cols1, cols2 []string
+		if p1.RowKey != nil {
+			cols1, _ = p1.RowKey.RowKey()
+		}
+		if p2.RowKey != nil {
+			cols2, _ = p2.RowKey.RowKey()
+		}
+		if !reflect.DeepEqual(cols1, cols2) {
+			return false
+		}
 	}
 	return true
 }
@@ -241,3 +400,19 @@ func (c *BulkCompilerOptions) canTakeMoreChunks(chunks int) bool {
 	}
 	return chunks < c.MaxPatches
 }
+
+func canTakeMoreParams(params int, maxParams int) bool {
+	if maxParams <= 0 {
+		return true
+	}
+	return params <= maxParams
+}
+
+// rowKeyParams counts bind parameters used by rowKey.
+func rowKeyParams(rowKey RowKey) int {
+	if rowKey == nil {
+		return 0
+	}
+	cols, _ := rowKey.RowKey()
+	return len(cols)
+}
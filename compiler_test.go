@@ -23,6 +23,16 @@ func (*testingDialect) ScanTypeOf(_ *sql.ColumnType) reflect.Type {
 	return nil
 }
 
+type testingLimitedDialect struct {
+	testingDialect
+
+	maxParams int
+}
+
+func (d *testingLimitedDialect) MaxBindParameters() int {
+	return d.maxParams
+}
+
 func TestPatchCompilerFunc(t *testing.T) {
 	assert.Implements(t, (*PatchCompiler)(nil), PatchCompilerFunc(func(opts *CompilerOptions) *SqlizerList {
 		return NewSqlizerList()
@@ -181,6 +191,22 @@ func TestDefaultCompiler(t *testing.T) {
 				sqr.Expr(`DELETE FROM "testing" WHERE (1=1)`),
 			},
 		},
+		{
+			[]*Patch{
+				UpdateAllPatch("testing", []string{"name", "count"}, []interface{}{"a", sqr.Expr(`"count" + ?`, 1)}, sqr.Lt{`"id"`: 10}),
+				// nil where: update all in the table
+				UpdateAllPatch("testing", []string{"name"}, []interface{}{"b"}, nil),
+				DeleteAllPatch("testing", sqr.Eq{`"name"`: "a"}),
+				// nil where: delete all in the table
+				DeleteAllPatch("testing", nil),
+			},
+			[]sqr.Sqlizer{
+				sqr.Expr(`UPDATE "testing" SET "name" = ?, "count" = "count" + ? WHERE "id" < ?`, "a", 1, 10),
+				sqr.Expr(`UPDATE "testing" SET "name" = ?`, "b"),
+				sqr.Expr(`DELETE FROM "testing" WHERE "name" = ?`, "a"),
+				sqr.Expr(`DELETE FROM "testing"`),
+			},
+		},
 	}
 	for _, c := range cases {
 		patches := NewPatchList()
@@ -725,3 +751,124 @@ func TestBulkCompiler(t *testing.T) {
 		}
 	})
 }
+
+func TestBulkCompilerWithBindParameterLimit(t *testing.T) {
+	rowKey := func(id int) RowKey {
+		return &MapRowKey{
+			Table: "testing",
+			Key: map[string]interface{}{
+				"id": id,
+			},
+		}
+	}
+	cases := []struct {
+		MaxPatches int
+		Patches    []*Patch
+		Sqlizers   []sqr.Sqlizer
+	}{
+		{
+			0,
+			[]*Patch{
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{2, "b"}),
+				InsertPatch("testing", []string{"id", "name"}, []interface{}{3, "c"}),
+			},
+			[]sqr.Sqlizer{
+				sqr.Expr(`INSERT INTO "testing" ("id","name") VALUES (?,?),(?,?)`, 1, "a", 2, "b"),
+				sqr.Expr(`INSERT INTO "testing" ("id","name") VALUES (?,?)`, 3, "c"),
+			},
+		},
+		{
+			0,
+			[]*Patch{
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"a", "memo"}, rowKey(1)),
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"a", "memo"}, rowKey(2)),
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"a", "memo"}, rowKey(3)),
+				UpdatePatch("testing", []string{"name", "memo"}, []interface{}{"a", "memo"}, rowKey(4)),
+			},
+			[]sqr.Sqlizer{
+				sqr.Expr(`UPDATE "testing" SET "name" = ?, "memo" = ? WHERE ("id" = ? OR "id" = ? OR "id" = ?)`, "a", "memo", 1, 2, 3),
+				sqr.Expr(`UPDATE "testing" SET "name" = ?, "memo" = ? WHERE ("id" = ?)`, "a", "memo", 4),
+			},
+		},
+		{
+			0,
+			[]*Patch{
+				DeletePatch("testing", rowKey(1)),
+				DeletePatch("testing", rowKey(2)),
+				DeletePatch("testing", rowKey(3)),
+				DeletePatch("testing", rowKey(4)),
+				DeletePatch("testing", rowKey(5)),
+				DeletePatch("testing", rowKey(6)),
+			},
+			[]sqr.Sqlizer{
+				sqr.Expr(`DELETE FROM "testing" WHERE ("id" = ? OR "id" = ? OR "id" = ? OR "id" = ? OR "id" = ?)`, 1, 2, 3, 4, 5),
+				sqr.Expr(`DELETE FROM "testing" WHERE ("id" = ?)`, 6),
+			},
+		},
+		{
+			// MaxPatches is an extra cap
+			2,
+			[]*Patch{
+				DeletePatch("testing", rowKey(1)),
+				DeletePatch("testing", rowKey(2)),
+				DeletePatch("testing", rowKey(3)),
+			},
+			[]sqr.Sqlizer{
+				sqr.Expr(`DELETE FROM "testing" WHERE ("id" = ? OR "id" = ?)`, 1, 2),
+				sqr.Expr(`DELETE FROM "testing" WHERE ("id" = ?)`, 3),
+			},
+		},
+	}
+	for _, c := range cases {
+		patches := NewPatchList()
+		for _, patch := range c.Patches {
+			patches.PushBack(patch)
+		}
+		sqlizers := (&BulkCompilerOptions{
+			MaxPatches: c.MaxPatches,
+		}).Compile(&CompilerOptions{
+			Dialect: &testingLimitedDialect{maxParams: 5},
+			Patches: patches,
+		})
+		if !assert.Equal(t, len(c.Sqlizers), sqlizers.Len()) {
+			continue
+		}
+
+		curr := sqlizers.Front()
+		for _, sqlizer := range c.Sqlizers {
+			expectQuery, expectArgs, err := sqlizer.ToSql()
+			if err != nil {
+				panic(err)
+			}
+			query, args, err := curr.GetValue().ToSql()
+			if !assert.NoError(t, err) {
+				continue
+			}
+			assert.Equal(t, expectQuery, query)
+			assert.Equal(t, expectArgs, args)
+
+			curr = curr.Next()
+		}
+	}
+}
+
+func TestCompilerUpsert(t *testing.T) {
+	patches := NewPatchList()
+	patches.PushBack(UpsertPatch("testing", []string{"id", "name"}, []interface{}{1, "a"}, &MapRowKey{
+		Table: "testing",
+		Key: map[string]interface{}{
+			"id": 1,
+		},
+	}))
+	for _, compiler := range []PatchCompiler{DefaultCompiler, BulkCompiler} {
+		sqlizers := compiler.Compile(&CompilerOptions{
+			Dialect: &testingDialect{},
+			Patches: patches,
+		})
+		if assert.Equal(t, 1, sqlizers.Len()) {
+			_, _, err := sqlizers.Front().GetValue().ToSql()
+			assert.EqualError(t, err, "goen: dialect does not support upsert", "reported by SaveChanges")
+		}
+	}
+}
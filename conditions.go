@@ -0,0 +1,38 @@
+package goen
+
+import (
+	sqr "github.com/Masterminds/squirrel"
+)
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func Or(conds ...sqr.Sqlizer) sqr.Sqlizer {
+	if len(conds) == 0 {
+		return sqr.Expr("1=0")
+	}
+	return sqr.Or(conds)
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func And(conds ...sqr.Sqlizer) sqr.Sqlizer {
+	if len(conds) == 0 {
+		return sqr.Expr("1=1")
+	}
+	return sqr.And(conds)
+}
+
+// Not makes a condition whether cond is false.
+func Not(cond sqr.Sqlizer) sqr.Sqlizer {
+	return &notSqlizer{cond}
+}
+
+type notSqlizer struct {
+	cond sqr.Sqlizer
+}
+
+func (s *notSqlizer) ToSql() (string, []interface{}, error) {
+	query, args, err := s.cond.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return "NOT (" + query + ")", args, nil
+}
@@ -0,0 +1,44 @@
+package goen
+
+import (
+	"testing"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditions(t *testing.T) {
+	cases := []struct {
+		Cond  sqr.Sqlizer
+		Query string
+		Args  []interface{}
+	}{
+		{
+			And(Or(sqr.Eq{"a": 1}, sqr.Eq{"b": 2}), Not(sqr.Eq{"c": 3})),
+			`((a = ? OR b = ?) AND NOT (c = ?))`,
+			[]interface{}{1, 2, 3},
+		},
+		{
+			Or(),
+			`1=0`,
+			nil,
+		},
+		{
+			And(),
+			`1=1`,
+			nil,
+		},
+		{
+			Not(And()),
+			`NOT (1=1)`,
+			nil,
+		},
+	}
+	for _, c := range cases {
+		query, args, err := c.Cond.ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.Query, query)
+			assert.Equal(t, c.Args, args)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package goen
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	convertersMu sync.RWMutex
+	converters   = make(map[reflect.Type]*converter)
+)
+
+type converter struct {
+	toDB func(interface{}) (driver.Value, error)
+
+	fromDB func(interface{}) (interface{}, error)
+}
+
+// RegisterConverter registers converters for typ, which is neither a sql.Scanner nor a driver.Valuer.
+// toDB converts a value of typ into a driver value, and fromDB converts a value scanned from the driver into a value of typ.
+// A pointer to typ is also converted; nil pointer is NULL.
+func RegisterConverter(typ reflect.Type, toDB func(interface{}) (driver.Value, error), fromDB func(interface{}) (interface{}, error)) {
+	if typ == nil || toDB == nil || fromDB == nil {
+		panic("goen: RegisterConverter called with nil")
+	}
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+
+	if _, dup := converters[typ]; dup {
+		panic("goen: RegisterConverter called twice for type " + typ.String())
+	}
+	converters[typ] = &converter{toDB, fromDB}
+}
+
+// lookupConverter gets a converter for typ or its element type of pointer.
+// ptr reports whether the converter is for the element type.
+func lookupConverter(typ reflect.Type) (conv *converter, ptr bool, ok bool) {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+
+	if conv, ok := converters[typ]; ok {
+		return conv, false, true
+	}
+	if typ.Kind() == reflect.Ptr {
+		if conv, ok := converters[typ.Elem()]; ok {
+			return conv, true, true
+		}
+	}
+	return nil, false, false
+}
+
+// ConvertValue wraps v into a driver.Valuer when a converter is registered for v's type.
+// Otherwise, it returns v as it is.
+func ConvertValue(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if conv, ptr, ok := lookupConverter(reflect.TypeOf(v)); ok {
+		return &convertedValue{v, conv, ptr}
+	}
+	return v
+}
+
+// ConvertValues is like ConvertValue, but for each element of slice v.
+func ConvertValues(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return ConvertValue(v)
+	}
+	if _, _, ok := lookupConverter(rv.Type().Elem()); !ok {
+		return v
+	}
+	vals := make([]interface{}, rv.Len())
+	for i := range vals {
+		vals[i] = ConvertValue(rv.Index(i).Interface())
+	}
+	return vals
+}
+
+// ConvertNullableValue is like ConvertValue, but it returns nil for a nil pointer or a driver.Valuer of NULL.
+// e.g. an invalid sql.NullString; then squirrel renders the comparison as IS NULL.
+func ConvertNullableValue(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	cv := ConvertValue(v)
+	if valuer, ok := cv.(driver.Valuer); ok {
+		if val, err := valuer.Value(); err == nil && val == nil {
+			return nil
+		}
+	}
+	return cv
+}
+
+// dbValueOf gets a driver value of v, for identifying it.
+func dbValueOf(v interface{}) interface{} {
+	cv, ok := ConvertValue(v).(*convertedValue)
+	if !ok {
+		return v
+	}
+	if val, err := cv.Value(); err == nil {
+		return val
+	}
+	return v
+}
+
+type convertedValue struct {
+	v interface{}
+
+	conv *converter
+
+	ptr bool
+}
+
+func (cv *convertedValue) Value() (driver.Value, error) {
+	if rv := reflect.ValueOf(cv.v); cv.ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return cv.conv.toDB(rv.Elem().Interface())
+	}
+	return cv.conv.toDB(cv.v)
+}
+
+func (cv *convertedValue) String() string {
+	return fmt.Sprint(cv.v)
+}
+
+// convertingScanner scans a value into dest via a converter.
+type convertingScanner struct {
+	dest reflect.Value
+
+	conv *converter
+
+	ptr bool
+}
+
+func (s *convertingScanner) Scan(src interface{}) error {
+	if src == nil {
+		s.dest.Set(reflect.Zero(s.dest.Type()))
+		return nil
+	}
+	v, err := s.conv.fromDB(src)
+	if err != nil {
+		return err
+	}
+	typ := s.dest.Type()
+	if s.ptr {
+		typ = typ.Elem()
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !rv.Type().ConvertibleTo(typ) {
+		return fmt.Errorf("goen: converter returns %T, expected %v", v, typ)
+	}
+	rv = rv.Convert(typ)
+	if s.ptr {
+		ptr := reflect.New(typ)
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+	s.dest.Set(rv)
+	return nil
+}
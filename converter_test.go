@@ -0,0 +1,147 @@
+package goen
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testingPoint struct {
+	X, Y int
+}
+
+func init() {
+	RegisterConverter(reflect.TypeOf(testingPoint{}), func(v interface{}) (driver.Value, error) {
+		p := v.(testingPoint)
+		return fmt.Sprintf("%d,%d", p.X, p.Y), nil
+	}, func(v interface{}) (interface{}, error) {
+		var p testingPoint
+		var s string
+		switch val := v.(type) {
+		case string:
+			s = val
+		case []byte:
+			s = string(val)
+		default:
+			return nil, fmt.Errorf("unexpected %T", v)
+		}
+		if _, err := fmt.Sscanf(s, "%d,%d", &p.X, &p.Y); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
+type PointRecord struct {
+	ID testingPoint `table:"point_records" primary_key:""`
+
+	Sub *testingPoint
+}
+
+func TestRegisterConverter(t *testing.T) {
+	toDB := func(interface{}) (driver.Value, error) { return nil, nil }
+	fromDB := func(interface{}) (interface{}, error) { return nil, nil }
+	assert.Panics(t, func() {
+		RegisterConverter(nil, toDB, fromDB)
+	})
+	assert.Panics(t, func() {
+		RegisterConverter(reflect.TypeOf(struct{}{}), nil, fromDB)
+	})
+	assert.Panics(t, func() {
+		RegisterConverter(reflect.TypeOf(struct{}{}), toDB, nil)
+	})
+	assert.Panics(t, func() {
+		RegisterConverter(reflect.TypeOf(testingPoint{}), toDB, fromDB)
+	})
+}
+
+func TestConvertValue(t *testing.T) {
+	valueOf := func(v interface{}) driver.Value {
+		valuer, ok := v.(driver.Valuer)
+		if !assert.True(t, ok, "%T is not a driver.Valuer", v) {
+			return nil
+		}
+		val, err := valuer.Value()
+		assert.NoError(t, err)
+		return val
+	}
+
+	assert.Equal(t, 1, ConvertValue(1))
+	assert.Nil(t, ConvertValue(nil))
+	assert.Equal(t, "1,2", valueOf(ConvertValue(testingPoint{1, 2})))
+	assert.Equal(t, "3,4", valueOf(ConvertValue(&testingPoint{3, 4})))
+	assert.Nil(t, valueOf(ConvertValue((*testingPoint)(nil))))
+
+	assert.Equal(t, []int{1, 2}, ConvertValues([]int{1, 2}))
+	if vals, ok := ConvertValues([]testingPoint{{1, 2}, {3, 4}}).([]interface{}); assert.True(t, ok) {
+		assert.Equal(t, "1,2", valueOf(vals[0]))
+		assert.Equal(t, "3,4", valueOf(vals[1]))
+	}
+}
+
+func TestConvertNullableValue(t *testing.T) {
+	assert.Nil(t, ConvertNullableValue(nil))
+	assert.Nil(t, ConvertNullableValue((*int)(nil)))
+	assert.Nil(t, ConvertNullableValue((*testingPoint)(nil)))
+	assert.Nil(t, ConvertNullableValue(sql.NullString{}))
+	assert.Equal(t, sql.NullString{String: "a", Valid: true}, ConvertNullableValue(sql.NullString{String: "a", Valid: true}))
+	i := 1
+	assert.Equal(t, &i, ConvertNullableValue(&i))
+	if valuer, ok := ConvertNullableValue(&testingPoint{1, 2}).(driver.Valuer); assert.True(t, ok) {
+		v, err := valuer.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "1,2", v)
+	}
+}
+
+func TestConverterMetaSchema(t *testing.T) {
+	m := new(metaSchema)
+	m.Register(PointRecord{})
+	m.Compute()
+
+	record := &PointRecord{ID: testingPoint{1, 2}}
+	patch := m.InsertPatchOf(record)
+	if assert.Len(t, patch.Values, 2) {
+		v, err := patch.Values[0].(driver.Valuer).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "1,2", v)
+		v, err = patch.Values[1].(driver.Valuer).Value()
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+	}
+
+	rowKey := m.PrimaryKeyOf(record)
+	assert.Equal(t, m.KeyStringFromRowKey(rowKey), m.KeyStringFromRowKey(&MapRowKey{
+		Table: "point_records",
+		Key: map[string]interface{}{
+			"id": "1,2",
+		},
+	}))
+}
+
+func TestConvertingScanner(t *testing.T) {
+	conv, ptr, ok := lookupConverter(reflect.TypeOf(testingPoint{}))
+	if !assert.True(t, ok) {
+		return
+	}
+	var p testingPoint
+	s := &convertingScanner{reflect.ValueOf(&p).Elem(), conv, ptr}
+	assert.NoError(t, s.Scan([]byte("5,6")))
+	assert.Equal(t, testingPoint{5, 6}, p)
+
+	conv, ptr, ok = lookupConverter(reflect.TypeOf(&testingPoint{}))
+	if !assert.True(t, ok) {
+		return
+	}
+	pp := &testingPoint{}
+	s = &convertingScanner{reflect.ValueOf(&pp).Elem(), conv, ptr}
+	assert.NoError(t, s.Scan("7,8"))
+	assert.Equal(t, &testingPoint{7, 8}, pp)
+	assert.NoError(t, s.Scan(nil))
+	assert.Nil(t, pp)
+	assert.Error(t, s.Scan(1))
+}
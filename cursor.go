@@ -0,0 +1,310 @@
+package goen
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	sqr "github.com/Masterminds/squirrel"
+)
+
+// Cursor is an opaque token of keyset pagination, empty for the first page.
+// It's signed by DBContext.CursorKey, then a tampered cursor is rejected.
+type Cursor string
+
+// CursorOrder is implemented by order expressions of columns, to be used by keyset pagination.
+type CursorOrder interface {
+	// CursorOrder gets a bare column name, and whether it's descending.
+	CursorOrder() (column string, desc bool)
+}
+
+// CursorOrderOf gets v as CursorOrder, or an error if v is not an order of a column; e.g. an aggregate.
+func CursorOrderOf(v interface{}) (CursorOrder, error) {
+	order, ok := v.(CursorOrder)
+	if !ok {
+		return nil, fmt.Errorf("goen: %T is not a column order for pagination", v)
+	}
+	return order, nil
+}
+
+var (
+	processCursorKey     []byte
+	processCursorKeyOnce sync.Once
+)
+
+// cursorKey gets the key for signing cursors, or a random key generated per process if it's empty.
+func (dbc *DBContext) cursorKey() []byte {
+	if len(dbc.CursorKey) > 0 {
+		return dbc.CursorKey
+	}
+	processCursorKeyOnce.Do(func() {
+		processCursorKey = make([]byte, 32)
+		if _, err := rand.Read(processCursorKey); err != nil {
+			panic("goen: unable to generate cursor key: " + err.Error())
+		}
+	})
+	return processCursorKey
+}
+
+type cursorPayload struct {
+	Table string `json:"t"`
+
+	// whether the cursor points to the previous page
+	Backward bool `json:"b"`
+
+	// order columns, prefixed by "-" for descending
+	Orders []string `json:"o"`
+
+	// values of order columns
+	Keys []json.RawMessage `json:"k"`
+}
+
+// Pagination builds queries and cursors of keyset pagination.
+type Pagination struct {
+	key []byte
+
+	table string
+
+	quote func(string) string
+
+	columns []MetaColumn
+
+	desc []bool
+
+	// nil for the first page
+	cursor *cursorPayload
+}
+
+// NewPagination makes a keyset pagination of metaT ordered by orders, the primary key is appended as a tie-breaker.
+// quote is used to quote column names in queries.
+func (dbc *DBContext) NewPagination(metaT MetaTable, orders []CursorOrder, after Cursor, quote func(string) string) (*Pagination, error) {
+	p := &Pagination{
+		key:   dbc.cursorKey(),
+		table: metaT.TableName(),
+		quote: quote,
+	}
+	seen := map[string]bool{}
+	for _, order := range orders {
+		column, desc := order.CursorOrder()
+		if seen[column] {
+			continue
+		}
+		metaC, ok := columnByName(metaT, column)
+		if !ok {
+			return nil, fmt.Errorf("goen: unknown column %q to paginate %s", column, metaT.TableName())
+		} else if metaC.JSON() {
+			return nil, fmt.Errorf("goen: unable to paginate by json column %q", column)
+		} else if isNullableType(metaC.Field().Type) {
+			// NULL is neither less nor greater than any value, then rows are skipped by keyset conditions
+			return nil, fmt.Errorf("goen: unable to paginate by nullable column %q", column)
+		}
+		seen[column] = true
+		p.columns = append(p.columns, metaC)
+		p.desc = append(p.desc, desc)
+	}
+	for _, metaC := range metaT.PrimaryKey() {
+		if seen[metaC.ColumnName()] {
+			continue
+		}
+		p.columns = append(p.columns, metaC)
+		p.desc = append(p.desc, false)
+	}
+	if after != "" {
+		cursor, err := p.decode(after)
+		if err != nil {
+			return nil, err
+		}
+		p.cursor = cursor
+	}
+	return p, nil
+}
+
+// isNullableType reports whether typ is a pointer or sql.NullXxx like type; a driver.Valuer having Valid field.
+func isNullableType(typ reflect.Type) bool {
+	valuerType := reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	if typ.Kind() == reflect.Ptr {
+		return true
+	}
+	if typ.Kind() != reflect.Struct || !typ.Implements(valuerType) && !reflect.PtrTo(typ).Implements(valuerType) {
+		return false
+	}
+	valid, ok := typ.FieldByName("Valid")
+	return ok && valid.Type.Kind() == reflect.Bool
+}
+
+func columnByName(metaT MetaTable, column string) (MetaColumn, bool) {
+	for _, metaC := range metaT.Columns() {
+		if metaC.ColumnName() == column {
+			return metaC, true
+		}
+	}
+	return nil, false
+}
+
+// Apply adds keyset conditions and orders to stmt, and limits to one more row than pageSize for detecting the next page.
+func (p *Pagination) Apply(stmt sqr.SelectBuilder, pageSize uint64) (sqr.SelectBuilder, error) {
+	if pageSize == 0 {
+		return stmt, errors.New("goen: page size must be positive")
+	}
+	backward := p.cursor != nil && p.cursor.Backward
+	if p.cursor != nil {
+		cond, err := p.where(backward)
+		if err != nil {
+			return stmt, err
+		}
+		stmt = stmt.Where(cond)
+	}
+	orderBys := make([]string, len(p.columns))
+	for i, metaC := range p.columns {
+		// the order is reversed for the previous page, then rows are reversed by Page
+		if p.desc[i] != backward {
+			orderBys[i] = p.quote(metaC.ColumnName()) + " DESC"
+		} else {
+			orderBys[i] = p.quote(metaC.ColumnName())
+		}
+	}
+	return stmt.OrderBy(orderBys...).Limit(pageSize + 1), nil
+}
+
+// where makes "(c1 > v1) OR (c1 = v1 AND c2 < v2) OR ..." for mixed directions.
+func (p *Pagination) where(backward bool) (sqr.Sqlizer, error) {
+	vals := make([]interface{}, len(p.columns))
+	for i, metaC := range p.columns {
+		v := reflect.New(metaC.Field().Type)
+		if err := json.Unmarshal(p.cursor.Keys[i], v.Interface()); err != nil {
+			return nil, fmt.Errorf("goen: invalid cursor value for %q: %s", metaC.ColumnName(), err)
+		}
+		vals[i] = ConvertValue(v.Elem().Interface())
+	}
+	or := sqr.Or{}
+	for i := range p.columns {
+		and := sqr.And{}
+		for j := 0; j < i; j++ {
+			and = append(and, sqr.Eq{p.quote(p.columns[j].ColumnName()): vals[j]})
+		}
+		column := p.quote(p.columns[i].ColumnName())
+		if p.desc[i] != backward {
+			and = append(and, sqr.Lt{column: vals[i]})
+		} else {
+			and = append(and, sqr.Gt{column: vals[i]})
+		}
+		or = append(or, and)
+	}
+	return or, nil
+}
+
+// Page trims records queried by Apply to pageSize, and makes cursors of the next and previous pages.
+// records is a pointer to a slice of entities, its order is restored for the previous page.
+// A cursor is empty when there are no more pages.
+func (p *Pagination) Page(records interface{}, pageSize uint64) (next Cursor, prev Cursor, err error) {
+	rv := reflect.ValueOf(records)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		panic(fmt.Sprintf("goen: Page only accepts a pointer to slice, not %T", records))
+	}
+	rv = rv.Elem()
+	more := uint64(rv.Len()) > pageSize
+	if more {
+		rv.Set(rv.Slice(0, int(pageSize)))
+	}
+	backward := p.cursor != nil && p.cursor.Backward
+	if backward {
+		swap := reflect.Swapper(rv.Interface())
+		for i, j := 0, rv.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+	if rv.Len() == 0 {
+		return "", "", nil
+	}
+	// going back from a page, the page always has the next page
+	hasNext := more || backward
+	hasPrev := p.cursor != nil
+	if backward {
+		hasPrev = more
+	}
+	if hasNext {
+		if next, err = p.encode(rv.Index(rv.Len()-1), false); err != nil {
+			return "", "", err
+		}
+	}
+	if hasPrev {
+		if prev, err = p.encode(rv.Index(0), true); err != nil {
+			return "", "", err
+		}
+	}
+	return next, prev, nil
+}
+
+func (p *Pagination) orders() []string {
+	orders := make([]string, len(p.columns))
+	for i, metaC := range p.columns {
+		if p.desc[i] {
+			orders[i] = "-" + metaC.ColumnName()
+		} else {
+			orders[i] = metaC.ColumnName()
+		}
+	}
+	return orders
+}
+
+func (p *Pagination) encode(record reflect.Value, backward bool) (Cursor, error) {
+	record = reflect.Indirect(record)
+	payload := &cursorPayload{
+		Table:    p.table,
+		Backward: backward,
+		Orders:   p.orders(),
+	}
+	for _, metaC := range p.columns {
+		b, err := json.Marshal(record.FieldByIndex(metaC.Field().Index).Interface())
+		if err != nil {
+			return "", fmt.Errorf("goen: unable to encode cursor value for %q: %s", metaC.ColumnName(), err)
+		}
+		payload.Keys = append(payload.Keys, b)
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return Cursor(enc.EncodeToString(b) + "." + enc.EncodeToString(p.sign(b))), nil
+}
+
+func (p *Pagination) decode(cursor Cursor) (*cursorPayload, error) {
+	invalid := errors.New("goen: invalid cursor")
+	parts := strings.Split(string(cursor), ".")
+	if len(parts) != 2 {
+		return nil, invalid
+	}
+	enc := base64.RawURLEncoding
+	b, err := enc.DecodeString(parts[0])
+	if err != nil {
+		return nil, invalid
+	}
+	mac, err := enc.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, p.sign(b)) {
+		return nil, invalid
+	}
+	var payload cursorPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, invalid
+	}
+	orders := p.orders()
+	if payload.Table != p.table || len(payload.Keys) != len(orders) || strings.Join(payload.Orders, ",") != strings.Join(orders, ",") {
+		return nil, errors.New("goen: cursor is made for another table or order")
+	}
+	return &payload, nil
+}
+
+func (p *Pagination) sign(b []byte) []byte {
+	h := hmac.New(sha256.New, p.key)
+	h.Write(b)
+	return h.Sum(nil)
+}
@@ -0,0 +1,130 @@
+package goen
+
+import (
+	"database/sql"
+	"testing"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+type testingCursorRecord struct {
+	ID int `table:"cursor_records" primary_key:""`
+
+	Name string
+
+	Score int
+
+	Nickname *string
+
+	Rank sql.NullInt64
+}
+
+type testingCursorOrder struct {
+	column string
+
+	desc bool
+}
+
+func (o testingCursorOrder) CursorOrder() (string, bool) {
+	return o.column, o.desc
+}
+
+func TestPagination(t *testing.T) {
+	m := new(metaSchema)
+	m.Register(testingCursorRecord{})
+	m.Compute()
+	metaT := m.LoadOf(&testingCursorRecord{})
+
+	dbc := &DBContext{CursorKey: []byte("secret")}
+	quote := func(s string) string { return `"` + s + `"` }
+	orders := []CursorOrder{
+		testingCursorOrder{"score", true},
+		testingCursorOrder{"name", false},
+	}
+	stmtBuilder := sqr.Select("*").From(`"cursor_records"`)
+
+	p, err := dbc.NewPagination(metaT, orders, "", quote)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = p.Apply(stmtBuilder, 0)
+	assert.Error(t, err, "page size must be positive")
+	stmt, err := p.Apply(stmtBuilder, 2)
+	if assert.NoError(t, err) {
+		query, _, err := stmt.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT * FROM "cursor_records" ORDER BY "score" DESC, "name", "id" LIMIT 3`, query, "the primary key is a tie-breaker")
+	}
+
+	records := []*testingCursorRecord{
+		{ID: 1, Name: "a", Score: 10},
+		{ID: 2, Name: "b", Score: 10},
+		{ID: 3, Name: "c", Score: 5},
+	}
+	next, prev, err := p.Page(&records, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, records, 2)
+	assert.NotEmpty(t, next)
+	assert.Empty(t, prev, "the first page has no previous page")
+
+	p, err = dbc.NewPagination(metaT, orders, next, quote)
+	if !assert.NoError(t, err) {
+		return
+	}
+	stmt, err = p.Apply(stmtBuilder, 2)
+	if assert.NoError(t, err) {
+		query, args, err := stmt.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT * FROM "cursor_records" WHERE (("score" < ?) OR ("score" = ? AND "name" > ?) OR ("score" = ? AND "name" = ? AND "id" > ?)) ORDER BY "score" DESC, "name", "id" LIMIT 3`, query)
+		assert.Equal(t, []interface{}{10, 10, "b", 10, "b", 2}, args)
+	}
+	records = []*testingCursorRecord{
+		{ID: 3, Name: "c", Score: 5},
+	}
+	next, prev, err = p.Page(&records, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, next, "the last page has no next page")
+	assert.NotEmpty(t, prev)
+
+	p, err = dbc.NewPagination(metaT, orders, prev, quote)
+	if !assert.NoError(t, err) {
+		return
+	}
+	stmt, err = p.Apply(stmtBuilder, 2)
+	if assert.NoError(t, err) {
+		query, args, err := stmt.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT * FROM "cursor_records" WHERE (("score" > ?) OR ("score" = ? AND "name" < ?) OR ("score" = ? AND "name" = ? AND "id" < ?)) ORDER BY "score", "name" DESC, "id" DESC LIMIT 3`, query, "the previous page is queried in reversed order")
+		assert.Equal(t, []interface{}{5, 5, "c", 5, "c", 3}, args)
+	}
+	records = []*testingCursorRecord{
+		{ID: 2, Name: "b", Score: 10},
+		{ID: 1, Name: "a", Score: 10},
+	}
+	next, prev, err = p.Page(&records, 2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*testingCursorRecord{{ID: 1, Name: "a", Score: 10}, {ID: 2, Name: "b", Score: 10}}, records, "rows are restored in order")
+		assert.NotEmpty(t, next)
+		assert.Empty(t, prev)
+	}
+
+	_, err = dbc.NewPagination(metaT, orders, next+"x", quote)
+	assert.Error(t, err, "tampered cursor is rejected")
+	_, err = dbc.NewPagination(metaT, orders, "invalid", quote)
+	assert.Error(t, err)
+	_, err = dbc.NewPagination(metaT, orders[:1], next, quote)
+	assert.Error(t, err, "cursor for another order is rejected")
+	_, err = (&DBContext{CursorKey: []byte("other")}).NewPagination(metaT, orders, next, quote)
+	assert.Error(t, err, "cursor signed by another key is rejected")
+	_, err = dbc.NewPagination(metaT, []CursorOrder{testingCursorOrder{"unknown", false}}, "", quote)
+	assert.Error(t, err)
+	_, err = dbc.NewPagination(metaT, []CursorOrder{testingCursorOrder{"nickname", false}}, "", quote)
+	assert.EqualError(t, err, `goen: unable to paginate by nullable column "nickname"`)
+	_, err = dbc.NewPagination(metaT, []CursorOrder{testingCursorOrder{"rank", false}}, "", quote)
+	assert.EqualError(t, err, `goen: unable to paginate by nullable column "rank"`)
+}
@@ -4,9 +4,11 @@ import (
 	"container/list"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
+	"sync"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen/dialect"
@@ -39,6 +41,24 @@ type DBContext struct {
 	// Default is 10.
 	MaxIncludeDepth int
 
+	// The maximum number of include loaders running concurrently at the same depth.
+	// Loaders implementing IncludeFetcher query concurrently, then fill entities on the calling goroutine in loaders order.
+	// Other loaders run sequentially.
+	// When this value is 0 or 1, or Tx is set, Include works sequentially.
+	// Default is 0.
+	IncludeConcurrency int
+
+	// The maximum number of row keys in a query issued by include loaders.
+	// The row keys are also limited by the dialect's maximum number of bind parameters.
+	// When this value is 0 or negative, the row keys are not chunked.
+	// Default is 1000.
+	IncludeChunkSize int
+
+	// The key to sign cursors of keyset pagination.
+	// When this value is empty, a random key per process is used, so cursors are invalid in other processes.
+	// Default is empty.
+	CursorKey []byte
+
 	// The runner for each query.
 	// This field is indented to hold one of *sql.DB, *sql.Tx or *StmtCacher.
 	QueryRunner QueryRunner
@@ -48,6 +68,8 @@ type DBContext struct {
 	debug bool
 
 	patchBuffer *PatchList
+
+	partials *partialRows
 }
 
 // NewDBContext creates DBContext with given dialectName and db.
@@ -59,11 +81,13 @@ func NewDBContext(dialectName string, db *sql.DB) *DBContext {
 		panic(fmt.Sprintf("goen: unknown dialect %q (forgotten import?)", dialectName))
 	}
 	return &DBContext{
-		DB:              db,
-		MaxIncludeDepth: 10,
-		QueryRunner:     db,
-		dialect:         dialect,
-		patchBuffer:     NewPatchList(),
+		DB:               db,
+		MaxIncludeDepth:  10,
+		IncludeChunkSize: 1000,
+		QueryRunner:      db,
+		dialect:          dialect,
+		patchBuffer:      NewPatchList(),
+		partials:         newPartialRows(),
 	}
 }
 
@@ -95,8 +119,11 @@ func (dbc *DBContext) UseTx(tx *sql.Tx) *DBContext {
 }
 
 // Patch adds raw patch into the buffer; without executing a query.
+// An update patch of a partially loaded row is dropped if none of its columns are loaded.
 func (dbc *DBContext) Patch(v *Patch) {
-	dbc.patchBuffer.PushBack(v)
+	if restricted := dbc.restrictPartial(v); restricted != nil {
+		dbc.patchBuffer.PushBack(restricted)
+	}
 }
 
 func (dbc *DBContext) QuerySqlizer(sqlizer sqr.Sqlizer) (*sql.Rows, error) {
@@ -176,10 +203,17 @@ func (dbc *DBContext) IncludeContext(ctx context.Context, v interface{}, sc *Sco
 	recordsList.PushBack(v)
 	for depth := 0; depth < dbc.MaxIncludeDepth; depth++ {
 		nextRecordsList := list.New()
-		for records := recordsList.Front(); records != nil; records = records.Next() {
-			if err := loader.Load(ctx, (*IncludeBuffer)(nextRecordsList), sc, records.Value); err != nil {
+		loaders, ok := loader.(IncludeLoaderList)
+		if ok && len(loaders) > 1 && dbc.IncludeConcurrency > 1 && dbc.Tx == nil {
+			if err := dbc.includeConcurrently(ctx, recordsList, nextRecordsList, sc, loaders); err != nil {
 				return err
 			}
+		} else {
+			for records := recordsList.Front(); records != nil; records = records.Next() {
+				if err := loader.Load(ctx, (*IncludeBuffer)(nextRecordsList), sc, records.Value); err != nil {
+					return err
+				}
+			}
 		}
 		if nextRecordsList.Len() == 0 {
 			return nil
@@ -189,6 +223,109 @@ func (dbc *DBContext) IncludeContext(ctx context.Context, v interface{}, sc *Sco
 	return nil
 }
 
+// includeConcurrently fetches by each loader implementing IncludeFetcher for all of recordsList with IncludeConcurrency workers.
+// Fetched entities are assigned on the calling goroutine in loaders order, and other loaders run in their turns.
+// Each loader has own buffer, they are merged into nextRecordsList in loaders order.
+// The first error cancels the others.
+func (dbc *DBContext) includeConcurrently(ctx context.Context, recordsList *list.List, nextRecordsList *list.List, sc *ScopeCache, loaders IncludeLoaderList) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, dbc.IncludeConcurrency)
+	buffers := make([]*list.List, len(loaders))
+	assigns := make([][]func(), len(loaders))
+	for i, loader := range loaders {
+		buffers[i] = list.New()
+		fetcher, ok := loader.(IncludeFetcher)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, fetcher IncludeFetcher, later *IncludeBuffer) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			for records := recordsList.Front(); records != nil; records = records.Next() {
+				assign, err := fetcher.Fetch(ctx, later, sc, records.Value)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				assigns[i] = append(assigns[i], assign)
+			}
+		}(i, fetcher, (*IncludeBuffer)(buffers[i]))
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	} else if err := ctx.Err(); err != nil {
+		// canceled by the caller
+		return err
+	}
+	for i, loader := range loaders {
+		if _, ok := loader.(IncludeFetcher); ok {
+			for _, assign := range assigns[i] {
+				assign()
+			}
+			continue
+		}
+		for records := recordsList.Front(); records != nil; records = records.Next() {
+			if err := loader.Load(ctx, (*IncludeBuffer)(buffers[i]), sc, records.Value); err != nil {
+				return err
+			}
+		}
+	}
+	for _, buffer := range buffers {
+		nextRecordsList.PushBackList(buffer)
+	}
+	return nil
+}
+
+// RowKeyConditions gets conditions matching rows by rowKeys, for each chunk of IncludeChunkSize.
+// Duplicated row keys are removed, so that a row is not loaded by more than one condition.
+// Include loaders issue a query for each condition.
+func (dbc *DBContext) RowKeyConditions(rowKeys []RowKey) []sqr.Sqlizer {
+	return dbc.RowKeyConditionsWithParams(rowKeys, 0)
+}
+
+// RowKeyConditionsWithParams is like RowKeyConditions, but chunks leave room for params bind parameters of other conditions.
+func (dbc *DBContext) RowKeyConditionsWithParams(rowKeys []RowKey, params int) []sqr.Sqlizer {
+	if len(rowKeys) == 0 {
+		return nil
+	}
+	size := dbc.IncludeChunkSize
+	if limiter, ok := dbc.dialect.(dialect.BindParameterLimiter); ok && limiter.MaxBindParameters() > 0 {
+		if cols, _ := rowKeys[0].RowKey(); len(cols) > 0 {
+			n := (limiter.MaxBindParameters() - params) / len(cols)
+			if n < 1 {
+				// the query exceeds the limit anyway, the database reports it
+				n = 1
+			}
+			if size <= 0 || n < size {
+				size = n
+			}
+		}
+	}
+	chunks := RowKeyList(rowKeys).Unique().Chunks(size)
+	conds := make([]sqr.Sqlizer, len(chunks))
+	for i := range chunks {
+		conds[i] = chunks[i].ToSqlizerWithDialect(dbc.dialect)
+	}
+	return conds
+}
+
 // CompilePatch compiles patches added by Patch() to SqlizerList.
 // When this function is called, will clear internal patch buffer.
 func (dbc *DBContext) CompilePatch() *SqlizerList {
@@ -205,6 +342,12 @@ func (dbc *DBContext) CompilePatch() *SqlizerList {
 	return compiler.Compile(opts)
 }
 
+// SqlizerExecer is an optional interface for a compiled Sqlizer.
+// When a Sqlizer implements it, SaveChanges calls its ExecContext instead of executing its ToSql result.
+type SqlizerExecer interface {
+	ExecContext(ctx context.Context, dbc *DBContext) error
+}
+
 func (dbc *DBContext) SaveChanges() error {
 	return dbc.SaveChangesContext(context.Background())
 }
@@ -220,6 +363,12 @@ func (dbc *DBContext) SaveChangesContext(ctx context.Context) error {
 		if dbc.debug {
 			dbc.debugPrintf("goen: %q with %v", query, args)
 		}
+		if execer, ok := sqlizer.(SqlizerExecer); ok {
+			if err := execer.ExecContext(ctx, dbc); err != nil {
+				return err
+			}
+			continue
+		}
 		if _, err := dbc.QueryRunner.ExecContext(ctx, query, args...); err != nil {
 			return err
 		}
@@ -261,6 +410,15 @@ func (dbc *DBContext) scanRowAsSlice(rows sqr.RowScanner, cols []*sql.ColumnType
 	if err := rows.Scan(args...); err != nil {
 		return reflect.Value{}, err
 	}
+	if scanner, ok := dbc.dialect.(dialect.ValueScanner); ok {
+		for i := range cols {
+			v, err := scanner.ScanValue(cols[i], dest[i])
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			dest[i] = v
+		}
+	}
 	return reflect.ValueOf(dest), nil
 }
 
@@ -285,13 +443,20 @@ func (dbc *DBContext) scanRowAsStruct(rows sqr.RowScanner, cols []*sql.ColumnTyp
 	args := make([]interface{}, len(cols))
 	for i := range cols {
 		var rfv reflect.Value
-		if field, ok := internal.FieldByFunc(fields, internal.EqColumnName(cols[i].Name())); ok {
-			rfv = dest.FieldByName(field.Name())
+		field, ok := internal.FieldByFunc(fields, internal.EqColumnName(cols[i].Name()))
+		if ok {
+			rfv = dest.FieldByIndex(field.Value().(reflect.StructField).Index)
 		}
 		if !rfv.IsValid() {
 			panic(fmt.Sprintf("goen: unknown struct field for column %q on %v", cols[i].Name(), rowTyp))
 		}
-		args[i] = rfv.Addr().Interface()
+		if internal.IsJSONField(field) {
+			args[i] = &jsonScanner{rfv}
+		} else if conv, ptr, ok := lookupConverter(rfv.Type()); ok {
+			args[i] = &convertingScanner{rfv, conv, ptr}
+		} else {
+			args[i] = rfv.Addr().Interface()
+		}
 	}
 	if err := rows.Scan(args...); err != nil {
 		return reflect.Value{}, err
@@ -299,6 +464,27 @@ func (dbc *DBContext) scanRowAsStruct(rows sqr.RowScanner, cols []*sql.ColumnTyp
 	return dest, nil
 }
 
+// jsonScanner scans a JSON serialized column value into dest.
+type jsonScanner struct {
+	dest reflect.Value
+}
+
+func (s *jsonScanner) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		s.dest.Set(reflect.Zero(s.dest.Type()))
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("goen: unable to unmarshal %T as json", src)
+	}
+	return json.Unmarshal(b, s.dest.Addr().Interface())
+}
+
 func (dbc *DBContext) debugPrint(v ...interface{}) {
 	if dbc.Logger != nil {
 		dbc.Logger.Print(v...)
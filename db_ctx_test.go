@@ -1,10 +1,17 @@
 package goen_test
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen"
@@ -25,6 +32,18 @@ func (s *TestingScanner) Scan(src interface{}) error {
 	return s.Value.Scan(src)
 }
 
+type TestingName struct {
+	Upper string
+}
+
+func init() {
+	goen.RegisterConverter(reflect.TypeOf(TestingName{}), func(v interface{}) (driver.Value, error) {
+		return strings.ToLower(v.(TestingName).Upper), nil
+	}, func(v interface{}) (interface{}, error) {
+		return TestingName{strings.ToUpper(fmt.Sprintf("%s", v))}, nil
+	})
+}
+
 func TestDBContext(t *testing.T) {
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
@@ -233,6 +252,78 @@ func TestDBContext(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Scan json column", func(t *testing.T) {
+		type Record struct {
+			ID       int64
+			Settings map[string]interface{} `column:",json"`
+			Tags     []string               `column:",json"`
+		}
+
+		rows, err := db.Query(`select id, '{"a":1}' as settings, null as tags from testing order by id limit 1`)
+		if err != nil {
+			panic(err)
+		}
+		defer rows.Close()
+
+		dbc := goen.NewDBContext("sqlite3", db)
+		var scannedRecords []*Record
+		if !assert.NoError(t, dbc.Scan(rows, &scannedRecords)) {
+			return
+		}
+		assert.Equal(t, []*Record{
+			{
+				ID: 1,
+				Settings: map[string]interface{}{
+					"a": float64(1),
+				},
+			},
+		}, scannedRecords)
+	})
+	t.Run("Scan converted column", func(t *testing.T) {
+		type Record struct {
+			ID   int64
+			Name *TestingName
+		}
+
+		rows, err := db.Query(`select id, name from testing order by id limit 1`)
+		if err != nil {
+			panic(err)
+		}
+		defer rows.Close()
+
+		dbc := goen.NewDBContext("sqlite3", db)
+		var scannedRecords []*Record
+		if !assert.NoError(t, dbc.Scan(rows, &scannedRecords)) {
+			return
+		}
+		assert.Equal(t, []*Record{
+			{ID: 1, Name: &TestingName{"FIRST"}},
+		}, scannedRecords)
+	})
+	t.Run("Scan embed column", func(t *testing.T) {
+		type Named struct {
+			Name string
+		}
+		type Record struct {
+			ID    int64
+			Named Named `embed:"testing_"`
+		}
+
+		rows, err := db.Query(`select id, name as testing_name from testing order by id limit 1`)
+		if err != nil {
+			panic(err)
+		}
+		defer rows.Close()
+
+		dbc := goen.NewDBContext("sqlite3", db)
+		var scannedRecords []*Record
+		if !assert.NoError(t, dbc.Scan(rows, &scannedRecords)) {
+			return
+		}
+		assert.Equal(t, []*Record{
+			{ID: 1, Named: Named{"first"}},
+		}, scannedRecords)
+	})
 	t.Run("UseTx", func(t *testing.T) {
 		tx, err := db.Begin()
 		if !assert.NoError(t, err) {
@@ -314,3 +405,135 @@ func TestDBContext(t *testing.T) {
 		assert.EqualValues(t, 99, n)
 	})
 }
+
+func TestDBContextIncludeConcurrency(t *testing.T) {
+	t.Run("bounded workers", func(t *testing.T) {
+		dbc := goen.NewDBContext("sqlite3", nil)
+		dbc.IncludeConcurrency = 2
+
+		var running, maxRunning int32
+		newLoader := func(name string) goen.IncludeLoader {
+			return goen.IncludeFetcherFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+				if _, ok := records.([]int); !ok {
+					return func() {}, nil
+				}
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				later.AddRecords([]string{name})
+				return func() {}, nil
+			})
+		}
+		var loaded []string
+		collector := goen.IncludeLoaderFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+			if names, ok := records.([]string); ok {
+				loaded = append(loaded, names...)
+			}
+			return nil
+		})
+		loaders := goen.IncludeLoaderList{newLoader("a"), newLoader("b"), newLoader("c"), collector}
+		err := dbc.IncludeContext(context.Background(), []int{1}, goen.NewScopeCache(goen.NewMetaSchema()), loaders)
+		if assert.NoError(t, err) {
+			assert.EqualValues(t, 2, maxRunning, "runs loaders with bounded workers")
+			assert.Equal(t, []string{"a", "b", "c"}, loaded, "merges buffers in loaders order")
+		}
+	})
+	t.Run("first error cancels others", func(t *testing.T) {
+		dbc := goen.NewDBContext("sqlite3", nil)
+		dbc.IncludeConcurrency = 2
+
+		expected := fmt.Errorf("loader failed")
+		var canceled int32
+		started := make(chan struct{})
+		loaders := goen.IncludeLoaderList{
+			goen.IncludeFetcherFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+				<-started
+				return nil, expected
+			}),
+			goen.IncludeFetcherFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+				close(started)
+				select {
+				case <-ctx.Done():
+					atomic.StoreInt32(&canceled, 1)
+					return nil, ctx.Err()
+				case <-time.After(time.Second):
+					return func() {}, nil
+				}
+			}),
+		}
+		err := dbc.IncludeContext(context.Background(), []int{1}, goen.NewScopeCache(goen.NewMetaSchema()), loaders)
+		assert.Equal(t, expected, err)
+		assert.EqualValues(t, 1, canceled, "other loaders are canceled via context")
+	})
+	t.Run("assigns on the calling goroutine", func(t *testing.T) {
+		dbc := goen.NewDBContext("sqlite3", nil)
+		dbc.IncludeConcurrency = 2
+
+		type record struct {
+			Names []string
+		}
+		newFetcher := func(name string) goen.IncludeLoader {
+			return goen.IncludeFetcherFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+				recs := records.([]*record)
+				time.Sleep(10 * time.Millisecond)
+				return func() {
+					for _, rec := range recs {
+						rec.Names = append(rec.Names, name)
+					}
+				}, nil
+			})
+		}
+		sequential := goen.IncludeLoaderFunc(func(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+			for _, rec := range records.([]*record) {
+				rec.Names = append(rec.Names, "seq")
+			}
+			return nil
+		})
+		recs := []*record{{}, {}}
+		loaders := goen.IncludeLoaderList{newFetcher("a"), sequential, newFetcher("a"), newFetcher("b")}
+		err := dbc.IncludeContext(context.Background(), recs, goen.NewScopeCache(goen.NewMetaSchema()), loaders)
+		if assert.NoError(t, err) {
+			for _, rec := range recs {
+				assert.Equal(t, []string{"a", "seq", "a", "b"}, rec.Names, "fills the same field in loaders order")
+			}
+		}
+	})
+}
+
+func TestDBContextRowKeyConditions(t *testing.T) {
+	rowKeysOf := func(n int, cols ...string) []goen.RowKey {
+		rowKeys := make([]goen.RowKey, n)
+		for i := range rowKeys {
+			key := map[string]interface{}{}
+			for _, col := range cols {
+				key[col] = i
+			}
+			rowKeys[i] = &goen.MapRowKey{Table: "testing", Key: key}
+		}
+		return rowKeys
+	}
+
+	dbc := goen.NewDBContext("sqlite3", nil)
+	assert.Equal(t, 1000, dbc.IncludeChunkSize)
+	assert.Nil(t, dbc.RowKeyConditions(nil))
+	assert.Len(t, dbc.RowKeyConditions(rowKeysOf(1000, "a")), 2, "chunked by the maximum number of bind parameters")
+	assert.Len(t, dbc.RowKeyConditions(rowKeysOf(1000, "a", "b")), 3, "chunked by the maximum number of bind parameters")
+	assert.Len(t, dbc.RowKeyConditionsWithParams(rowKeysOf(999, "a"), 1), 2, "chunked leaving room for other bind parameters")
+	assert.Len(t, dbc.RowKeyConditionsWithParams(rowKeysOf(2, "a"), 999), 2, "chunked by a key at least")
+
+	dbc.IncludeChunkSize = 2
+	conds := dbc.RowKeyConditions(append(rowKeysOf(5, "a"), rowKeysOf(5, "a")...))
+	if assert.Len(t, conds, 3, "chunked by IncludeChunkSize without duplicated row keys") {
+		query, args, err := conds[2].ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "`a` IN (?)", query)
+			assert.Equal(t, []interface{}{4}, args)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package dialect
 import (
 	"database/sql"
 	"reflect"
+	"time"
 
 	sqr "github.com/Masterminds/squirrel"
 )
@@ -14,3 +15,133 @@ type Dialect interface {
 
 	ScanTypeOf(*sql.ColumnType) reflect.Type
 }
+
+// BindParameterLimiter is an optional interface for Dialect.
+type BindParameterLimiter interface {
+	// MaxBindParameters reports the maximum number of bind parameters in a statement.
+	// MaxBindParameters<=0 means unlimited.
+	MaxBindParameters() int
+}
+
+// ValueScanner is an optional interface for Dialect.
+// DBContext uses it for scanning a row into a slice or a map.
+type ValueScanner interface {
+	// ScanValue converts src scanned from the driver into a value of ScanTypeOf's type.
+	// src is nil for NULL.
+	ScanValue(ct *sql.ColumnType, src interface{}) (interface{}, error)
+}
+
+// JSONOperator is an optional interface for Dialect, for querying JSON columns.
+// Each method renders an expression with "?" placeholders and its args.
+// Given column is quoted, and it returns an error when given operator is not supported.
+type JSONOperator interface {
+	// JSONExtractText renders an expression to extract a value at path in column.
+	JSONExtractText(column string, path []string) (string, []interface{}, error)
+
+	// JSONHasKey renders a condition whether column has key at the top level.
+	JSONHasKey(column string, key string) (string, []interface{}, error)
+
+	// JSONContains renders a condition whether column contains value, value is serialized as JSON.
+	JSONContains(column string, value string) (string, []interface{}, error)
+}
+
+// Capabilities is an optional interface for Dialect.
+// It exposes dialect specific SQL features, and it's expected to be implemented by all dialects.
+// Use package dialecttest for checking an implementation.
+type Capabilities interface {
+	Dialect
+
+	// SupportsReturning reports whether "RETURNING" clause is supported.
+	SupportsReturning() bool
+
+	// SupportsWindowFunctions reports whether window functions like "ROW_NUMBER() OVER (...)" are supported.
+	SupportsWindowFunctions() bool
+
+	// SupportsRowValues reports whether row value comparisons like "(a, b) IN ((?, ?), (?, ?))" are supported.
+	SupportsRowValues() bool
+
+	// UpsertClause renders a clause following "INSERT ... VALUES (...)".
+	// The clause updates updateColumns of the existing row conflicting by conflictColumns.
+	// When updateColumns is empty, the existing row is left as it is.
+	// Given column names are unquoted.
+	UpsertClause(conflictColumns []string, updateColumns []string) (string, error)
+
+	// BoolLiteral renders a boolean literal.
+	BoolLiteral(bool) string
+
+	// TimeLiteral renders a time literal.
+	TimeLiteral(time.Time) string
+
+	// Explain renders a statement to explain given query.
+	Explain(query string) string
+
+	// LockClause renders a row-locking clause following "SELECT ...".
+	// When tables are given, only rows of them are locked; e.g. "FOR UPDATE OF ...". Given table names are quoted.
+	// It returns an error when given lock is not supported.
+	LockClause(mode LockMode, wait LockWait, tables ...string) (string, error)
+
+	// MaxIdentifierLength reports the maximum length of an identifier in bytes.
+	// MaxIdentifierLength<=0 means unlimited.
+	MaxIdentifierLength() int
+
+	// QuoteQualified quotes a schema-qualified identifier; e.g. "schema"."table".
+	QuoteQualified(names ...string) string
+
+	// ClassifyError classifies an error returned by the driver.
+	ClassifyError(error) ErrorClass
+}
+
+// LastInsertIDUpserter is an optional interface for Capabilities.
+// It's for databases reporting an auto-increment column via sql.Result.LastInsertId.
+type LastInsertIDUpserter interface {
+	// LastInsertIDUpsertClause is like UpsertClause,
+	// but it also makes LastInsertId report idColumn of the existing row when it's updated.
+	LastInsertIDUpsertClause(conflictColumns []string, updateColumns []string, idColumn string) (string, error)
+}
+
+// LockMode represents a strength of row-locking.
+type LockMode int
+
+const (
+	LockForUpdate LockMode = iota
+	LockForShare
+)
+
+// LockWait represents a behavior of row-locking when rows are already locked.
+type LockWait int
+
+const (
+	// LockWaitDefault waits until rows are released.
+	LockWaitDefault LockWait = iota
+	// LockNoWait reports an error without waiting.
+	LockNoWait
+	// LockSkipLocked skips already locked rows.
+	LockSkipLocked
+)
+
+// ErrorClass represents a kind of database errors.
+type ErrorClass int
+
+const (
+	ErrorUnknown ErrorClass = iota
+	ErrorUniqueViolation
+	ErrorForeignKeyViolation
+	ErrorNotNullViolation
+	// ErrorSerializationFailure means the transaction can be retried.
+	ErrorSerializationFailure
+)
+
+func (v ErrorClass) String() string {
+	switch v {
+	case ErrorUniqueViolation:
+		return "UniqueViolation"
+	case ErrorForeignKeyViolation:
+		return "ForeignKeyViolation"
+	case ErrorNotNullViolation:
+		return "NotNullViolation"
+	case ErrorSerializationFailure:
+		return "SerializationFailure"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,173 @@
+// Package dialecttest provides a conformance test suite for dialect.Dialect implementations.
+//
+// A dialect package runs it from its own test:
+//
+//   func TestConformance(t *testing.T) {
+//       dialecttest.Run(t, &myDialect{})
+//   }
+package dialecttest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kamichidu/goen/dialect"
+)
+
+// Suite is a conformance test suite for a dialect.
+type Suite struct {
+	Dialect dialect.Dialect
+
+	// Errors holds driver errors for each class.
+	// When Dialect implements dialect.Capabilities, each error is expected to be classified as its class.
+	Errors map[dialect.ErrorClass]error
+}
+
+// Run runs a conformance test suite for d.
+func Run(t *testing.T, d dialect.Dialect) {
+	(&Suite{Dialect: d}).Run(t)
+}
+
+// Run runs a conformance test suite.
+func (s *Suite) Run(t *testing.T) {
+	t.Run("Dialect", s.testDialect)
+	if _, ok := s.Dialect.(dialect.BindParameterLimiter); ok {
+		t.Run("BindParameterLimiter", s.testBindParameterLimiter)
+	}
+	if _, ok := s.Dialect.(dialect.Capabilities); ok {
+		t.Run("Capabilities", s.testCapabilities)
+	}
+}
+
+func (s *Suite) testDialect(t *testing.T) {
+	d := s.Dialect
+	t.Run("PlaceholderFormat", func(t *testing.T) {
+		format := d.PlaceholderFormat()
+		if format == nil {
+			t.Fatal("PlaceholderFormat returns nil")
+		}
+		query, err := format.ReplacePlaceholders("SELECT ? WHERE ?")
+		if err != nil {
+			t.Fatalf("ReplacePlaceholders returns an error: %s", err)
+		}
+		if strings.Count(query, "?") == 1 {
+			t.Errorf("ReplacePlaceholders must replace all placeholders or nothing, but got %q", query)
+		}
+	})
+	t.Run("Quote", func(t *testing.T) {
+		for _, name := range []string{"name", "order", "with space"} {
+			quoted := d.Quote(name)
+			if quoted == name {
+				t.Errorf("Quote(%q) returns unquoted identifier", name)
+			}
+			if !strings.Contains(quoted, name) {
+				t.Errorf("Quote(%q) returns %q, it's not containing the identifier", name, quoted)
+			}
+		}
+		// quote characters must be escaped
+		names := []string{"ab", `a"b`, "a`b", "a'b", `a""b`, "a``b"}
+		for i := range names {
+			for j := range names {
+				if i != j && d.Quote(names[i]) == d.Quote(names[j]) {
+					t.Errorf("Quote returns the same identifier for %q and %q", names[i], names[j])
+				}
+			}
+		}
+	})
+}
+
+func (s *Suite) testBindParameterLimiter(t *testing.T) {
+	limiter := s.Dialect.(dialect.BindParameterLimiter)
+	if v := limiter.MaxBindParameters(); v < 0 {
+		t.Errorf("MaxBindParameters returns negative value %d, use 0 for unlimited", v)
+	}
+}
+
+func (s *Suite) testCapabilities(t *testing.T) {
+	d := s.Dialect.(dialect.Capabilities)
+	t.Run("UpsertClause", func(t *testing.T) {
+		clause, err := d.UpsertClause([]string{"id"}, []string{"name"})
+		if err != nil {
+			t.Fatalf("UpsertClause returns an error for updating: %s", err)
+		}
+		if !strings.Contains(clause, d.Quote("name")) {
+			t.Errorf("UpsertClause returns %q, it's not containing an update column", clause)
+		}
+		clause, err = d.UpsertClause([]string{"id"}, nil)
+		if err != nil {
+			t.Fatalf("UpsertClause returns an error for doing nothing: %s", err)
+		}
+		if clause == "" {
+			t.Error("UpsertClause returns an empty clause for doing nothing")
+		}
+	})
+	t.Run("BoolLiteral", func(t *testing.T) {
+		if d.BoolLiteral(true) == "" || d.BoolLiteral(false) == "" {
+			t.Error("BoolLiteral returns an empty literal")
+		}
+		if d.BoolLiteral(true) == d.BoolLiteral(false) {
+			t.Error("BoolLiteral returns the same literal for true and false")
+		}
+	})
+	t.Run("TimeLiteral", func(t *testing.T) {
+		t1 := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+		t2 := t1.Add(time.Second)
+		if d.TimeLiteral(t1) == "" {
+			t.Error("TimeLiteral returns an empty literal")
+		}
+		if d.TimeLiteral(t1) == d.TimeLiteral(t2) {
+			t.Error("TimeLiteral returns the same literal for different times")
+		}
+	})
+	t.Run("Explain", func(t *testing.T) {
+		query := "SELECT 1"
+		if explain := d.Explain(query); explain == query || !strings.Contains(explain, query) {
+			t.Errorf("Explain returns %q, it's not explaining %q", explain, query)
+		}
+	})
+	t.Run("LockClause", func(t *testing.T) {
+		for _, mode := range []dialect.LockMode{dialect.LockForUpdate, dialect.LockForShare} {
+			for _, wait := range []dialect.LockWait{dialect.LockWaitDefault, dialect.LockNoWait, dialect.LockSkipLocked} {
+				clause, err := d.LockClause(mode, wait)
+				if err == nil && clause == "" {
+					t.Errorf("LockClause(%v, %v) returns an empty clause without error", mode, wait)
+				} else if err != nil && clause != "" {
+					t.Errorf("LockClause(%v, %v) returns a clause with error", mode, wait)
+				}
+				table := d.Quote("table")
+				if clause, err := d.LockClause(mode, wait, table); err == nil && !strings.Contains(clause, table) {
+					t.Errorf("LockClause(%v, %v, %q) returns %q, it's not locking the table", mode, wait, table, clause)
+				}
+			}
+		}
+	})
+	t.Run("MaxIdentifierLength", func(t *testing.T) {
+		if v := d.MaxIdentifierLength(); v < 0 {
+			t.Errorf("MaxIdentifierLength returns negative value %d, use 0 for unlimited", v)
+		}
+	})
+	t.Run("QuoteQualified", func(t *testing.T) {
+		if v := d.QuoteQualified("table"); v != d.Quote("table") {
+			t.Errorf("QuoteQualified(%q) returns %q, expected %q", "table", v, d.Quote("table"))
+		}
+		expect := d.Quote("schema") + "." + d.Quote("table")
+		if v := d.QuoteQualified("schema", "table"); v != expect {
+			t.Errorf("QuoteQualified(%q, %q) returns %q, expected %q", "schema", "table", v, expect)
+		}
+	})
+	t.Run("ClassifyError", func(t *testing.T) {
+		if v := d.ClassifyError(nil); v != dialect.ErrorUnknown {
+			t.Errorf("ClassifyError(nil) returns %v", v)
+		}
+		if v := d.ClassifyError(errors.New("goen: testing")); v != dialect.ErrorUnknown {
+			t.Errorf("ClassifyError returns %v for non-driver error", v)
+		}
+		for class, err := range s.Errors {
+			if v := d.ClassifyError(err); v != class {
+				t.Errorf("ClassifyError(%v) returns %v, expected %v", err, v, class)
+			}
+		}
+	})
+}
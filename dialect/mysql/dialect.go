@@ -0,0 +1,201 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/go-sql-driver/mysql"
+	"github.com/kamichidu/goen"
+	goendialect "github.com/kamichidu/goen/dialect"
+)
+
+var (
+	typeString     = reflect.TypeOf("")
+	typeNullString = reflect.TypeOf(sql.NullString{})
+	typeBytes      = reflect.TypeOf([]byte{})
+)
+
+type dialect struct{}
+
+func (d *dialect) PlaceholderFormat() sqr.PlaceholderFormat {
+	return sqr.Question
+}
+
+func (d *dialect) Quote(s string) string {
+	return "`" + strings.Replace(s, "`", "``", -1) + "`"
+}
+
+func (d *dialect) ScanTypeOf(ct *sql.ColumnType) reflect.Type {
+	// the driver reports sql.RawBytes for most of non-numeric types,
+	// it's only valid until the next scan.
+	nullable, _ := ct.Nullable()
+	switch ct.DatabaseTypeName() {
+	case "CHAR", "VARCHAR", "TINYTEXT", "TEXT", "MEDIUMTEXT", "LONGTEXT", "ENUM", "SET", "JSON", "TIME":
+		if nullable {
+			return typeNullString
+		}
+		return typeString
+	case "DECIMAL":
+		// keep its precision
+		if nullable {
+			return typeNullString
+		}
+		return typeString
+	case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB", "BIT", "GEOMETRY":
+		return typeBytes
+	default:
+		return ct.ScanType()
+	}
+}
+
+func (d *dialect) MaxBindParameters() int {
+	// the protocol uses uint16 for the number of parameters
+	return 65535
+}
+
+func (d *dialect) SupportsReturning() bool {
+	return false
+}
+
+func (d *dialect) SupportsWindowFunctions() bool {
+	// window functions are supported since mysql 8.0
+	return false
+}
+
+func (d *dialect) SupportsRowValues() bool {
+	return true
+}
+
+func (d *dialect) UpsertClause(conflictColumns []string, updateColumns []string) (string, error) {
+	return d.upsertClause(conflictColumns, updateColumns, nil)
+}
+
+func (d *dialect) LastInsertIDUpsertClause(conflictColumns []string, updateColumns []string, idColumn string) (string, error) {
+	// LAST_INSERT_ID(expr) makes LastInsertId report the existing row's id
+	return d.upsertClause(conflictColumns, updateColumns, []string{
+		d.Quote(idColumn) + " = LAST_INSERT_ID(" + d.Quote(idColumn) + ")",
+	})
+}
+
+func (d *dialect) upsertClause(conflictColumns []string, updateColumns []string, sets []string) (string, error) {
+	// mysql detects a conflict by any of unique indexes, conflictColumns are not rendered
+	for _, col := range updateColumns {
+		sets = append(sets, d.Quote(col)+" = VALUES("+d.Quote(col)+")")
+	}
+	if len(sets) == 0 {
+		if len(conflictColumns) == 0 {
+			return "", errors.New("goen: mysql requires conflict columns for doing nothing on conflict")
+		}
+		// no-op update, for ignoring only duplicate key errors unlike "INSERT IGNORE"
+		sets = append(sets, d.Quote(conflictColumns[0])+" = "+d.Quote(conflictColumns[0]))
+	}
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", "), nil
+}
+
+func (d *dialect) BoolLiteral(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func (d *dialect) TimeLiteral(v time.Time) string {
+	// mysql has no time zone for DATETIME, the driver sends a time in UTC by default
+	return "TIMESTAMP '" + v.UTC().Format("2006-01-02 15:04:05.999999") + "'"
+}
+
+func (d *dialect) Explain(query string) string {
+	return "EXPLAIN " + query
+}
+
+func (d *dialect) LockClause(mode goendialect.LockMode, wait goendialect.LockWait, tables ...string) (string, error) {
+	var clause string
+	switch mode {
+	case goendialect.LockForUpdate:
+		clause = "FOR UPDATE"
+	case goendialect.LockForShare:
+		// "FOR SHARE", "NOWAIT" and "SKIP LOCKED" are supported since mysql 8.0
+		clause = "FOR SHARE"
+	default:
+		return "", errors.New("goen: unknown lock mode")
+	}
+	if len(tables) > 0 {
+		clause += " OF " + strings.Join(tables, ", ")
+	}
+	switch wait {
+	case goendialect.LockWaitDefault:
+	case goendialect.LockNoWait:
+		clause += " NOWAIT"
+	case goendialect.LockSkipLocked:
+		clause += " SKIP LOCKED"
+	default:
+		return "", errors.New("goen: unknown lock wait")
+	}
+	return clause, nil
+}
+
+func (d *dialect) MaxIdentifierLength() int {
+	return 64
+}
+
+func (d *dialect) QuoteQualified(names ...string) string {
+	quoted := make([]string, len(names))
+	for i := range names {
+		quoted[i] = d.Quote(names[i])
+	}
+	return strings.Join(quoted, ".")
+}
+
+func (d *dialect) ClassifyError(err error) goendialect.ErrorClass {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return goendialect.ErrorUnknown
+	}
+	switch mysqlErr.Number {
+	case 1062: // ER_DUP_ENTRY
+		return goendialect.ErrorUniqueViolation
+	case 1451, 1452: // ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2
+		return goendialect.ErrorForeignKeyViolation
+	case 1048: // ER_BAD_NULL_ERROR
+		return goendialect.ErrorNotNullViolation
+	case 1213: // ER_LOCK_DEADLOCK
+		return goendialect.ErrorSerializationFailure
+	default:
+		return goendialect.ErrorUnknown
+	}
+}
+
+func (d *dialect) JSONExtractText(column string, path []string) (string, []interface{}, error) {
+	return "JSON_UNQUOTE(JSON_EXTRACT(" + column + ", ?))", []interface{}{jsonPath(path)}, nil
+}
+
+func (d *dialect) JSONHasKey(column string, key string) (string, []interface{}, error) {
+	return "JSON_CONTAINS_PATH(" + column + ", 'one', ?)", []interface{}{jsonPath([]string{key})}, nil
+}
+
+func (d *dialect) JSONContains(column string, value string) (string, []interface{}, error) {
+	return "JSON_CONTAINS(" + column + ", ?)", []interface{}{value}, nil
+}
+
+// jsonPath makes a json path; e.g. []string{"a", "b"} to `$."a"."b"`.
+func jsonPath(path []string) string {
+	s := "$"
+	for _, key := range path {
+		s += `."` + strings.Replace(key, `"`, `\"`, -1) + `"`
+	}
+	return s
+}
+
+var (
+	_ goendialect.Capabilities         = (*dialect)(nil)
+	_ goendialect.JSONOperator         = (*dialect)(nil)
+	_ goendialect.LastInsertIDUpserter = (*dialect)(nil)
+)
+
+func init() {
+	goen.Register("mysql", &dialect{})
+}
@@ -0,0 +1,170 @@
+package mysql
+
+import (
+	"database/sql"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/kamichidu/goen"
+	goendialect "github.com/kamichidu/goen/dialect"
+	"github.com/kamichidu/goen/dialect/dialecttest"
+	"github.com/stretchr/testify/assert"
+)
+
+var d = &dialect{}
+
+func TestDialect(t *testing.T) {
+	t.Run("Quote", func(t *testing.T) {
+		cases := []struct {
+			S string
+			R string
+		}{
+			{"hoge", "`hoge`"},
+			{"ho\"ge", "`ho\"ge`"},
+			{"ho`ge", "`ho``ge`"},
+			{"ho``ge", "`ho````ge`"},
+		}
+		for _, c := range cases {
+			assert.Equal(t, c.R, d.Quote(c.S))
+		}
+	})
+	t.Run("MaxBindParameters", func(t *testing.T) {
+		assert.Equal(t, 65535, d.MaxBindParameters())
+	})
+	t.Run("UpsertClause", func(t *testing.T) {
+		clause, err := d.UpsertClause([]string{"id"}, []string{"name", "age"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `age` = VALUES(`age`)", clause)
+
+		clause, err = d.UpsertClause([]string{"id"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "ON DUPLICATE KEY UPDATE `id` = `id`", clause)
+
+		_, err = d.UpsertClause(nil, nil)
+		assert.Error(t, err)
+
+		clause, err = d.LastInsertIDUpsertClause([]string{"email"}, []string{"name"}, "id")
+		assert.NoError(t, err)
+		assert.Equal(t, "ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`)", clause)
+	})
+	t.Run("JSONOperator", func(t *testing.T) {
+		expr, args, err := d.JSONExtractText("`col`", []string{"a", "b"})
+		assert.NoError(t, err)
+		assert.Equal(t, "JSON_UNQUOTE(JSON_EXTRACT(`col`, ?))", expr)
+		assert.Equal(t, []interface{}{`$."a"."b"`}, args)
+
+		expr, args, err = d.JSONHasKey("`col`", "a")
+		assert.NoError(t, err)
+		assert.Equal(t, "JSON_CONTAINS_PATH(`col`, 'one', ?)", expr)
+		assert.Equal(t, []interface{}{`$."a"`}, args)
+
+		expr, args, err = d.JSONContains("`col`", `{"a":1}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "JSON_CONTAINS(`col`, ?)", expr)
+		assert.Equal(t, []interface{}{`{"a":1}`}, args)
+	})
+}
+
+func TestScanTypeOf(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	cases := []struct {
+		Column *sqlmock.Column
+		Type   reflect.Type
+	}{
+		{sqlmock.NewColumn("a").OfType("VARCHAR", sql.RawBytes{}).Nullable(false), reflect.TypeOf("")},
+		{sqlmock.NewColumn("b").OfType("TEXT", sql.RawBytes{}).Nullable(true), reflect.TypeOf(sql.NullString{})},
+		{sqlmock.NewColumn("c").OfType("DECIMAL", sql.RawBytes{}).Nullable(false), reflect.TypeOf("")},
+		{sqlmock.NewColumn("d").OfType("VARBINARY", sql.RawBytes{}).Nullable(true), reflect.TypeOf([]byte{})},
+		{sqlmock.NewColumn("e").OfType("BIGINT", int64(0)).Nullable(false), reflect.TypeOf(int64(0))},
+		{sqlmock.NewColumn("f").OfType("DATETIME", mysql.NullTime{}).Nullable(true), reflect.TypeOf(mysql.NullTime{})},
+	}
+	var cols []*sqlmock.Column
+	for _, c := range cases {
+		cols = append(cols, c.Column)
+	}
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRowsWithColumnDefinition(cols...))
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		panic(err)
+	}
+	for i, c := range cases {
+		assert.Equal(t, c.Type, d.ScanTypeOf(cts[i]), "column %s", cts[i].DatabaseTypeName())
+	}
+}
+
+func TestSaveChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	rowKey := func(id int64) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "testing",
+			Key: map[string]interface{}{
+				"id": id,
+			},
+		}
+	}
+
+	dbc := goen.NewDBContext("mysql", db)
+	dbc.Compiler = goen.BulkCompiler
+	dbc.Patch(goen.InsertPatch("testing", []string{"id", "name"}, []interface{}{int64(1), "a"}))
+	dbc.Patch(goen.UpsertPatch("testing", []string{"id", "name"}, []interface{}{int64(1), "b"}, rowKey(1)))
+	dbc.Patch(goen.UpsertPatch("testing", []string{"id", "name"}, []interface{}{int64(2), "c"}, rowKey(2)))
+	upsert := goen.UpsertPatch("testing", []string{"email"}, []interface{}{"x@example.com"}, &goen.MapRowKey{
+		Table: "testing",
+		Key: map[string]interface{}{
+			"email": "x@example.com",
+		},
+	})
+	upsert.AutoIncrementColumn = "id"
+	dbc.Patch(upsert)
+	dbc.Patch(goen.DeletePatch("testing", rowKey(1)))
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `testing` (`id`,`name`) VALUES (?,?)")).
+		WithArgs(1, "a").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `testing` (`id`,`name`) VALUES (?,?),(?,?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)")).
+		WithArgs(1, "b", 2, "c").
+		WillReturnResult(sqlmock.NewResult(2, 3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `testing` (`email`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`)")).
+		WithArgs("x@example.com").
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `testing` WHERE (`id` = ?)")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if !assert.NoError(t, dbc.SaveChanges()) {
+		return
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConformance(t *testing.T) {
+	(&dialecttest.Suite{
+		Dialect: d,
+		Errors: map[goendialect.ErrorClass]error{
+			goendialect.ErrorUniqueViolation:      &mysql.MySQLError{Number: 1062},
+			goendialect.ErrorForeignKeyViolation:  &mysql.MySQLError{Number: 1452},
+			goendialect.ErrorNotNullViolation:     &mysql.MySQLError{Number: 1048},
+			goendialect.ErrorSerializationFailure: &mysql.MySQLError{Number: 1213},
+			goendialect.ErrorUnknown:              &mysql.MySQLError{Number: 1064},
+		},
+	}).Run(t)
+}
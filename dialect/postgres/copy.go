@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamichidu/goen"
+	"github.com/lib/pq"
+)
+
+// CopyCompiler is a goen.PatchCompiler for loading many rows.
+// It compiles runs of compatible insert patches into "COPY ... FROM STDIN" via lib/pq's CopyIn support.
+// Compatible insert patches have the same table name and the same columns in the same order.
+// Other patches are compiled by Fallback, keeping overall order.
+//
+// COPY is only allowed inside a transaction; call SaveChanges on a DBContext given by UseTx.
+// When a CompilerHook is set, all patches are compiled by Fallback, since a hook can't modify COPY.
+type CopyCompiler struct {
+	// Fallback compiles patches not to be copied.
+	// Fallback defaults to goen.DefaultCompiler.
+	Fallback goen.PatchCompiler
+
+	// MinRows limits minimum number of insert patches to be copied at once.
+	// Shorter runs are compiled by Fallback.
+	// MinRows<=1 means always copying.
+	MinRows int
+}
+
+func (c *CopyCompiler) Compile(opts *goen.CompilerOptions) *goen.SqlizerList {
+	fallback := c.Fallback
+	if fallback == nil {
+		fallback = goen.DefaultCompiler
+	}
+	if opts.Hook != nil {
+		return fallback.Compile(opts)
+	}
+
+	sqlizers := goen.NewSqlizerList()
+	pending := goen.NewPatchList()
+	flush := func() {
+		if pending.Len() == 0 {
+			return
+		}
+		sqlizers.PushBackList(fallback.Compile(&goen.CompilerOptions{
+			Dialect: opts.Dialect,
+			Patches: pending,
+		}))
+		pending = goen.NewPatchList()
+	}
+	for curr := opts.Patches.Front(); curr != nil; {
+		patch := curr.GetValue()
+		if patch.Kind != goen.PatchInsert || patch.Err != nil {
+			pending.PushBack(patch)
+			curr = curr.Next()
+			continue
+		}
+		run := []*goen.Patch{patch}
+		for curr = curr.Next(); curr != nil && c.isCompat(patch, curr.GetValue()); curr = curr.Next() {
+			run = append(run, curr.GetValue())
+		}
+		if len(run) < c.MinRows {
+			for _, v := range run {
+				pending.PushBack(v)
+			}
+			continue
+		}
+		flush()
+		stmt := &copyIn{
+			tableName: patch.TableName,
+			columns:   patch.Columns,
+		}
+		for _, v := range run {
+			stmt.rows = append(stmt.rows, v.Values)
+		}
+		sqlizers.PushBack(stmt)
+	}
+	flush()
+	return sqlizers
+}
+
+func (c *CopyCompiler) isCompat(p1, p2 *goen.Patch) bool {
+	if p1.Kind != p2.Kind || p2.Err != nil {
+		return false
+	}
+	if p1.TableName != p2.TableName {
+		return false
+	}
+	if len(p1.Columns) != len(p2.Columns) {
+		return false
+	}
+	for i := range p1.Columns {
+		if p1.Columns[i] != p2.Columns[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// copyIn streams rows through "COPY ... FROM STDIN".
+type copyIn struct {
+	tableName string
+
+	columns []string
+
+	rows [][]interface{}
+}
+
+func (stmt *copyIn) ToSql() (string, []interface{}, error) {
+	return pq.CopyIn(stmt.tableName, stmt.columns...), nil, nil
+}
+
+func (stmt *copyIn) ExecContext(ctx context.Context, dbc *goen.DBContext) error {
+	if dbc.Tx == nil {
+		return errors.New("goen: COPY is only allowed inside a transaction, use DBContext.UseTx")
+	}
+	query, _, err := stmt.ToSql()
+	if err != nil {
+		return err
+	}
+	prepared, err := dbc.Tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer prepared.Close() // nolint: errcheck
+	for _, row := range stmt.rows {
+		if _, err := prepared.ExecContext(ctx, row...); err != nil {
+			return err
+		}
+	}
+	// flush buffered rows
+	if _, err := prepared.ExecContext(ctx); err != nil {
+		return err
+	}
+	return prepared.Close()
+}
+
+var _ goen.SqlizerExecer = (*copyIn)(nil)
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kamichidu/goen"
+	"github.com/stretchr/testify/assert"
+)
+
+// copyTestingDriver is a fake driver that captures executed statements.
+type copyTestingDriver struct {
+	log []string
+}
+
+func (d *copyTestingDriver) Open(name string) (driver.Conn, error) {
+	return &copyTestingConn{d}, nil
+}
+
+type copyTestingConn struct {
+	d *copyTestingDriver
+}
+
+func (c *copyTestingConn) Prepare(query string) (driver.Stmt, error) {
+	return &copyTestingStmt{c.d, query}, nil
+}
+
+func (c *copyTestingConn) Close() error {
+	return nil
+}
+
+func (c *copyTestingConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *copyTestingConn) Commit() error {
+	return nil
+}
+
+func (c *copyTestingConn) Rollback() error {
+	return nil
+}
+
+type copyTestingStmt struct {
+	d *copyTestingDriver
+
+	query string
+}
+
+func (s *copyTestingStmt) Close() error {
+	return nil
+}
+
+func (s *copyTestingStmt) NumInput() int {
+	return -1
+}
+
+func (s *copyTestingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if strings.HasPrefix(s.query, "COPY ") {
+		if len(args) == 0 {
+			s.d.log = append(s.d.log, "copy flush")
+		} else {
+			s.d.log = append(s.d.log, fmt.Sprintf("copy %v", args))
+		}
+	} else {
+		s.d.log = append(s.d.log, fmt.Sprintf("exec %s %v", s.query, args))
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *copyTestingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+var copyDriver = &copyTestingDriver{}
+
+func init() {
+	sql.Register("goen-copy-testing", copyDriver)
+}
+
+func TestCopyCompiler(t *testing.T) {
+	db, err := sql.Open("goen-copy-testing", "")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	insertPatch := func(id int64, name string) *goen.Patch {
+		return goen.InsertPatch("testing", []string{"id", "name"}, []interface{}{id, name})
+	}
+	deletePatch := func(id int64) *goen.Patch {
+		return goen.DeletePatch("testing", &goen.MapRowKey{
+			Table: "testing",
+			Key: map[string]interface{}{
+				"id": id,
+			},
+		})
+	}
+
+	t.Run("streams insert runs via COPY", func(t *testing.T) {
+		copyDriver.log = nil
+
+		tx, err := db.Begin()
+		if err != nil {
+			panic(err)
+		}
+		defer tx.Rollback() // nolint: errcheck
+
+		dbc := goen.NewDBContext("postgres", db).UseTx(tx)
+		dbc.Compiler = &CopyCompiler{}
+		dbc.Patch(insertPatch(1, "a"))
+		dbc.Patch(insertPatch(2, "b"))
+		dbc.Patch(deletePatch(1))
+		dbc.Patch(insertPatch(3, "c"))
+		dbc.Patch(goen.InsertPatch("testing", []string{"id"}, []interface{}{int64(4)}))
+		if !assert.NoError(t, dbc.SaveChanges()) {
+			return
+		}
+		assert.Equal(t, []string{
+			"copy [1 a]",
+			"copy [2 b]",
+			"copy flush",
+			`exec DELETE FROM "testing" WHERE "id" = $1 [1]`,
+			"copy [3 c]",
+			"copy flush",
+			"copy [4]",
+			"copy flush",
+		}, copyDriver.log)
+	})
+	t.Run("falls back short runs", func(t *testing.T) {
+		copyDriver.log = nil
+
+		tx, err := db.Begin()
+		if err != nil {
+			panic(err)
+		}
+		defer tx.Rollback() // nolint: errcheck
+
+		dbc := goen.NewDBContext("postgres", db).UseTx(tx)
+		dbc.Compiler = &CopyCompiler{
+			Fallback: goen.BulkCompiler,
+			MinRows:  2,
+		}
+		dbc.Patch(insertPatch(1, "a"))
+		dbc.Patch(deletePatch(1))
+		dbc.Patch(deletePatch(2))
+		dbc.Patch(insertPatch(3, "c"))
+		dbc.Patch(insertPatch(4, "d"))
+		if !assert.NoError(t, dbc.SaveChanges()) {
+			return
+		}
+		assert.Equal(t, []string{
+			`exec INSERT INTO "testing" ("id","name") VALUES ($1,$2) [1 a]`,
+			`exec DELETE FROM "testing" WHERE ("id" = $1 OR "id" = $2) [1 2]`,
+			"copy [3 c]",
+			"copy [4 d]",
+			"copy flush",
+		}, copyDriver.log)
+	})
+	t.Run("requires a transaction", func(t *testing.T) {
+		copyDriver.log = nil
+
+		dbc := goen.NewDBContext("postgres", db)
+		dbc.Compiler = &CopyCompiler{}
+		dbc.Patch(insertPatch(1, "a"))
+		assert.Error(t, dbc.SaveChanges())
+		assert.Empty(t, copyDriver.log)
+	})
+	t.Run("compiles COPY statement", func(t *testing.T) {
+		patches := goen.NewPatchList()
+		patches.PushBack(insertPatch(1, "a"))
+		sqlizers := (&CopyCompiler{}).Compile(&goen.CompilerOptions{
+			Dialect: &dialect{},
+			Patches: patches,
+		})
+		if !assert.Equal(t, 1, sqlizers.Len()) {
+			return
+		}
+		query, args, err := sqlizers.Front().GetValue().ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, `COPY "testing" ("id", "name") FROM STDIN`, query)
+		assert.Empty(t, args)
+	})
+}
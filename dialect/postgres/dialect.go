@@ -2,11 +2,15 @@ package postgres
 
 import (
 	"database/sql"
+	"errors"
 	"reflect"
 	"strings"
+	"time"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen"
+	goendialect "github.com/kamichidu/goen/dialect"
+	"github.com/lib/pq"
 )
 
 type dialect struct{}
@@ -23,6 +27,147 @@ func (d *dialect) ScanTypeOf(ct *sql.ColumnType) reflect.Type {
 	return ct.ScanType()
 }
 
+func (d *dialect) MaxBindParameters() int {
+	// the protocol uses uint16 for the number of parameters
+	return 65535
+}
+
+func (d *dialect) SupportsReturning() bool {
+	return true
+}
+
+func (d *dialect) SupportsWindowFunctions() bool {
+	return true
+}
+
+func (d *dialect) SupportsRowValues() bool {
+	return true
+}
+
+func (d *dialect) UpsertClause(conflictColumns []string, updateColumns []string) (string, error) {
+	var target string
+	if len(conflictColumns) > 0 {
+		target = " (" + d.quoteJoin(conflictColumns, ",") + ")"
+	}
+	if len(updateColumns) == 0 {
+		return "ON CONFLICT" + target + " DO NOTHING", nil
+	}
+	if target == "" {
+		return "", errors.New("goen: postgres requires conflict columns for updating on conflict")
+	}
+	sets := make([]string, len(updateColumns))
+	for i, col := range updateColumns {
+		sets[i] = d.Quote(col) + " = EXCLUDED." + d.Quote(col)
+	}
+	return "ON CONFLICT" + target + " DO UPDATE SET " + strings.Join(sets, ", "), nil
+}
+
+func (d *dialect) BoolLiteral(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func (d *dialect) TimeLiteral(v time.Time) string {
+	return "TIMESTAMP WITH TIME ZONE '" + v.Format("2006-01-02 15:04:05.999999999Z07:00") + "'"
+}
+
+func (d *dialect) Explain(query string) string {
+	return "EXPLAIN " + query
+}
+
+func (d *dialect) LockClause(mode goendialect.LockMode, wait goendialect.LockWait, tables ...string) (string, error) {
+	var clause string
+	switch mode {
+	case goendialect.LockForUpdate:
+		clause = "FOR UPDATE"
+	case goendialect.LockForShare:
+		clause = "FOR SHARE"
+	default:
+		return "", errors.New("goen: unknown lock mode")
+	}
+	if len(tables) > 0 {
+		clause += " OF " + strings.Join(tables, ", ")
+	}
+	switch wait {
+	case goendialect.LockWaitDefault:
+	case goendialect.LockNoWait:
+		clause += " NOWAIT"
+	case goendialect.LockSkipLocked:
+		clause += " SKIP LOCKED"
+	default:
+		return "", errors.New("goen: unknown lock wait")
+	}
+	return clause, nil
+}
+
+func (d *dialect) MaxIdentifierLength() int {
+	// NAMEDATALEN-1
+	return 63
+}
+
+func (d *dialect) QuoteQualified(names ...string) string {
+	return d.quoteJoin(names, ".")
+}
+
+func (d *dialect) ClassifyError(err error) goendialect.ErrorClass {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return goendialect.ErrorUnknown
+	}
+	switch pqErr.Code {
+	case "23505":
+		return goendialect.ErrorUniqueViolation
+	case "23503":
+		return goendialect.ErrorForeignKeyViolation
+	case "23502":
+		return goendialect.ErrorNotNullViolation
+	case "40001":
+		return goendialect.ErrorSerializationFailure
+	default:
+		return goendialect.ErrorUnknown
+	}
+}
+
+func (d *dialect) JSONExtractText(column string, path []string) (string, []interface{}, error) {
+	// for jsonb and json
+	expr := column
+	args := make([]interface{}, len(path))
+	for i := range path {
+		if i == len(path)-1 {
+			expr += " ->> ?::text"
+		} else {
+			expr += " -> ?::text"
+		}
+		args[i] = path[i]
+	}
+	return "(" + expr + ")", args, nil
+}
+
+func (d *dialect) JSONHasKey(column string, key string) (string, []interface{}, error) {
+	// for jsonb, "??" will be replaced to "?" by placeholder format
+	return column + " ?? ?::text", []interface{}{key}, nil
+}
+
+func (d *dialect) JSONContains(column string, value string) (string, []interface{}, error) {
+	// for jsonb
+	return column + " @> ?::jsonb", []interface{}{value}, nil
+}
+
+func (d *dialect) quoteJoin(l []string, sep string) string {
+	quoted := make([]string, len(l))
+	for i := range l {
+		quoted[i] = d.Quote(l[i])
+	}
+	return strings.Join(quoted, sep)
+}
+
+var (
+	_ goendialect.Capabilities = (*dialect)(nil)
+	_ goendialect.JSONOperator = (*dialect)(nil)
+)
+
 func init() {
 	goen.Register("postgres", &dialect{})
 }
@@ -3,6 +3,9 @@ package postgres
 import (
 	"testing"
 
+	goendialect "github.com/kamichidu/goen/dialect"
+	"github.com/kamichidu/goen/dialect/dialecttest"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,4 +26,37 @@ func TestDialect(t *testing.T) {
 			assert.Equal(t, c.R, d.Quote(c.S))
 		}
 	})
+	t.Run("MaxBindParameters", func(t *testing.T) {
+		assert.Implements(t, (*goendialect.BindParameterLimiter)(nil), d)
+		assert.Equal(t, 65535, d.MaxBindParameters())
+	})
+	t.Run("JSONOperator", func(t *testing.T) {
+		expr, args, err := d.JSONExtractText(`"col"`, []string{"a", "b"})
+		assert.NoError(t, err)
+		assert.Equal(t, `("col" -> ?::text ->> ?::text)`, expr)
+		assert.Equal(t, []interface{}{"a", "b"}, args)
+
+		expr, args, err = d.JSONHasKey(`"col"`, "a")
+		assert.NoError(t, err)
+		assert.Equal(t, `"col" ?? ?::text`, expr)
+		assert.Equal(t, []interface{}{"a"}, args)
+
+		expr, args, err = d.JSONContains(`"col"`, `{"a":1}`)
+		assert.NoError(t, err)
+		assert.Equal(t, `"col" @> ?::jsonb`, expr)
+		assert.Equal(t, []interface{}{`{"a":1}`}, args)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	(&dialecttest.Suite{
+		Dialect: d,
+		Errors: map[goendialect.ErrorClass]error{
+			goendialect.ErrorUniqueViolation:      &pq.Error{Code: "23505"},
+			goendialect.ErrorForeignKeyViolation:  &pq.Error{Code: "23503"},
+			goendialect.ErrorNotNullViolation:     &pq.Error{Code: "23502"},
+			goendialect.ErrorSerializationFailure: &pq.Error{Code: "40001"},
+			goendialect.ErrorUnknown:              &pq.Error{Code: "42601"},
+		},
+	}).Run(t)
 }
@@ -2,27 +2,289 @@ package sqlite3
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen"
+	goendialect "github.com/kamichidu/goen/dialect"
+	"github.com/mattn/go-sqlite3"
 )
 
-type dialect struct{}
+var (
+	typeTime   = reflect.TypeOf(time.Time{})
+	typeBool   = reflect.TypeOf(false)
+	typeString = reflect.TypeOf("")
+	typeJSON   = reflect.TypeOf(json.RawMessage{})
+	typeBytes  = reflect.TypeOf([]byte{})
+)
+
+// Dialect is a dialect for sqlite3, it's registered as "sqlite3".
+// Since sqlite3 has no strict column types, scanned values are converted by their declared types.
+// Register other instance for other configuration:
+//
+//	goen.Register("sqlite3-local", &sqlite3.Dialect{Location: time.Local})
+type Dialect struct {
+	// TimeLayouts is used for parsing a text as a time.
+	// TimeLayouts defaults to go-sqlite3's SQLiteTimestampFormats.
+	// Note that go-sqlite3 driver parses a text by itself when the declared type is exactly DATETIME, TIMESTAMP or DATE.
+	TimeLayouts []string
+
+	// Location is a time zone of scanned times, they're converted into it.
+	// A text without time zone is regarded as UTC, same as go-sqlite3 driver.
+	// Location defaults to UTC.
+	Location *time.Location
+}
 
-func (d *dialect) PlaceholderFormat() sqr.PlaceholderFormat {
+func (d *Dialect) PlaceholderFormat() sqr.PlaceholderFormat {
 	return sqr.Question
 }
 
-func (d *dialect) Quote(s string) string {
+func (d *Dialect) Quote(s string) string {
 	return "`" + strings.Replace(s, "`", "``", -1) + "`"
 }
 
-func (d *dialect) ScanTypeOf(ct *sql.ColumnType) reflect.Type {
-	return ct.ScanType()
+func (d *Dialect) ScanTypeOf(ct *sql.ColumnType) reflect.Type {
+	switch declaredType(ct) {
+	case "DATETIME", "TIMESTAMP", "DATE":
+		return typeTime
+	case "BOOLEAN", "BOOL":
+		return typeBool
+	case "DECIMAL", "NUMERIC":
+		// keep its precision as mysql does
+		return typeString
+	case "JSON":
+		return typeJSON
+	case "BLOB":
+		return typeBytes
+	default:
+		return ct.ScanType()
+	}
+}
+
+func (d *Dialect) ScanValue(ct *sql.ColumnType, src interface{}) (interface{}, error) {
+	if src == nil {
+		return nil, nil
+	}
+	switch declaredType(ct) {
+	case "DATETIME", "TIMESTAMP", "DATE":
+		return d.scanTime(src)
+	case "BOOLEAN", "BOOL":
+		switch v := src.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		case string:
+			return strconv.ParseBool(v)
+		case []byte:
+			return strconv.ParseBool(string(v))
+		}
+	case "DECIMAL", "NUMERIC":
+		// a value may be stored as integer or real by its affinity, or as text when it's not a number
+		switch v := src.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		}
+	case "JSON":
+		switch v := src.(type) {
+		case string:
+			return json.RawMessage(v), nil
+		case []byte:
+			return json.RawMessage(append([]byte{}, v...)), nil
+		}
+	case "BLOB":
+		switch v := src.(type) {
+		case string:
+			return []byte(v), nil
+		case []byte:
+			return append([]byte{}, v...), nil
+		}
+	default:
+		return src, nil
+	}
+	return nil, fmt.Errorf("goen: unable to convert %T into %v for column %q", src, d.ScanTypeOf(ct), ct.Name())
+}
+
+func (d *Dialect) scanTime(src interface{}) (interface{}, error) {
+	loc := d.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var s string
+	switch v := src.(type) {
+	case time.Time:
+		return v.In(loc), nil
+	case int64:
+		// same to go-sqlite3 driver, unix time in seconds
+		return time.Unix(v, 0).In(loc), nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return nil, fmt.Errorf("goen: unable to convert %T into time.Time", src)
+	}
+	layouts := d.TimeLayouts
+	if len(layouts) == 0 {
+		layouts = sqlite3.SQLiteTimestampFormats
+	}
+	s = strings.TrimSuffix(s, "Z")
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.In(loc), nil
+		}
+	}
+	return nil, fmt.Errorf("goen: unable to parse %q as time.Time", s)
+}
+
+func (d *Dialect) MaxBindParameters() int {
+	// SQLITE_MAX_VARIABLE_NUMBER defaults to 999 prior to sqlite 3.32.0
+	return 999
+}
+
+func (d *Dialect) SupportsReturning() bool {
+	// RETURNING is supported since sqlite 3.35.0
+	return false
+}
+
+func (d *Dialect) SupportsWindowFunctions() bool {
+	// window functions are supported since sqlite 3.25.0
+	return true
+}
+
+func (d *Dialect) SupportsRowValues() bool {
+	// row values are supported since sqlite 3.15.0
+	return true
+}
+
+func (d *Dialect) UpsertClause(conflictColumns []string, updateColumns []string) (string, error) {
+	// UPSERT is supported since sqlite 3.24.0
+	var target string
+	if len(conflictColumns) > 0 {
+		target = " (" + d.quoteJoin(conflictColumns, ",") + ")"
+	}
+	if len(updateColumns) == 0 {
+		return "ON CONFLICT" + target + " DO NOTHING", nil
+	}
+	if target == "" {
+		return "", errors.New("goen: sqlite3 requires conflict columns for updating on conflict")
+	}
+	sets := make([]string, len(updateColumns))
+	for i, col := range updateColumns {
+		sets[i] = d.Quote(col) + " = excluded." + d.Quote(col)
+	}
+	return "ON CONFLICT" + target + " DO UPDATE SET " + strings.Join(sets, ", "), nil
+}
+
+func (d *Dialect) BoolLiteral(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+func (d *Dialect) TimeLiteral(v time.Time) string {
+	// same format to go-sqlite3 driver's storing
+	return "'" + v.Format(sqlite3.SQLiteTimestampFormats[0]) + "'"
+}
+
+func (d *Dialect) Explain(query string) string {
+	return "EXPLAIN QUERY PLAN " + query
+}
+
+func (d *Dialect) LockClause(mode goendialect.LockMode, wait goendialect.LockWait, tables ...string) (string, error) {
+	// sqlite3 locks whole database by a transaction, there are no row-locking
+	return "", errors.New("goen: sqlite3 does not support row-locking clauses")
+}
+
+func (d *Dialect) MaxIdentifierLength() int {
+	// no limit
+	return 0
+}
+
+func (d *Dialect) QuoteQualified(names ...string) string {
+	return d.quoteJoin(names, ".")
+}
+
+func (d *Dialect) ClassifyError(err error) goendialect.ErrorClass {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return goendialect.ErrorUnknown
+	}
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		return goendialect.ErrorUniqueViolation
+	case sqlite3.ErrConstraintForeignKey:
+		return goendialect.ErrorForeignKeyViolation
+	case sqlite3.ErrConstraintNotNull:
+		return goendialect.ErrorNotNullViolation
+	}
+	switch sqliteErr.Code {
+	case sqlite3.ErrBusy, sqlite3.ErrLocked:
+		// the transaction can be retried, likewise serialization failure
+		return goendialect.ErrorSerializationFailure
+	default:
+		return goendialect.ErrorUnknown
+	}
 }
 
+func (d *Dialect) JSONExtractText(column string, path []string) (string, []interface{}, error) {
+	// requires json1 extension
+	return "json_extract(" + column + ", ?)", []interface{}{jsonPath(path)}, nil
+}
+
+func (d *Dialect) JSONHasKey(column string, key string) (string, []interface{}, error) {
+	return "EXISTS (SELECT 1 FROM json_each(" + column + ") WHERE key = ?)", []interface{}{key}, nil
+}
+
+func (d *Dialect) JSONContains(column string, value string) (string, []interface{}, error) {
+	return "", nil, errors.New("goen: sqlite3 does not support json containment")
+}
+
+func (d *Dialect) quoteJoin(l []string, sep string) string {
+	quoted := make([]string, len(l))
+	for i := range l {
+		quoted[i] = d.Quote(l[i])
+	}
+	return strings.Join(quoted, sep)
+}
+
+// jsonPath makes a json1 path; e.g. []string{"a", "b"} to `$."a"."b"`.
+func jsonPath(path []string) string {
+	s := "$"
+	for _, key := range path {
+		s += `."` + strings.Replace(key, `"`, `\"`, -1) + `"`
+	}
+	return s
+}
+
+// declaredType returns normalized declared type of ct; e.g. "decimal(10, 2)" to "DECIMAL".
+func declaredType(ct *sql.ColumnType) string {
+	s := strings.ToUpper(ct.DatabaseTypeName())
+	if idx := strings.IndexByte(s, '('); idx >= 0 {
+		s = s[:idx]
+	}
+	return strings.TrimSpace(s)
+}
+
+var (
+	_ goendialect.Capabilities = (*Dialect)(nil)
+	_ goendialect.ValueScanner = (*Dialect)(nil)
+	_ goendialect.JSONOperator = (*Dialect)(nil)
+)
+
 func init() {
-	goen.Register("sqlite3", &dialect{})
+	goen.Register("sqlite3", &Dialect{})
 }
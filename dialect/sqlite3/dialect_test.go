@@ -1,12 +1,20 @@
 package sqlite3
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/kamichidu/goen"
+	goendialect "github.com/kamichidu/goen/dialect"
+	"github.com/kamichidu/goen/dialect/dialecttest"
+	"github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 )
 
-var d = &dialect{}
+var d = &Dialect{}
 
 func TestDialect(t *testing.T) {
 	t.Run("Quote", func(t *testing.T) {
@@ -23,4 +31,160 @@ func TestDialect(t *testing.T) {
 			assert.Equal(t, c.R, d.Quote(c.S))
 		}
 	})
+	t.Run("MaxBindParameters", func(t *testing.T) {
+		assert.Implements(t, (*goendialect.BindParameterLimiter)(nil), d)
+		assert.Equal(t, 999, d.MaxBindParameters())
+	})
+	t.Run("JSONOperator", func(t *testing.T) {
+		expr, args, err := d.JSONExtractText("`col`", []string{"a", `b"c`})
+		assert.NoError(t, err)
+		assert.Equal(t, "json_extract(`col`, ?)", expr)
+		assert.Equal(t, []interface{}{`$."a"."b\"c"`}, args)
+
+		expr, args, err = d.JSONHasKey("`col`", "a")
+		assert.NoError(t, err)
+		assert.Equal(t, "EXISTS (SELECT 1 FROM json_each(`col`) WHERE key = ?)", expr)
+		assert.Equal(t, []interface{}{"a"}, args)
+
+		_, _, err = d.JSONContains("`col`", `{"a":1}`)
+		assert.Error(t, err)
+	})
+}
+
+func TestConformance(t *testing.T) {
+	(&dialecttest.Suite{
+		Dialect: d,
+		Errors: map[goendialect.ErrorClass]error{
+			goendialect.ErrorUniqueViolation:      sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique},
+			goendialect.ErrorForeignKeyViolation:  sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintForeignKey},
+			goendialect.ErrorNotNullViolation:     sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintNotNull},
+			goendialect.ErrorSerializationFailure: sqlite3.Error{Code: sqlite3.ErrBusy},
+			goendialect.ErrorUnknown:              sqlite3.Error{Code: sqlite3.ErrError},
+		},
+	}).Run(t)
+}
+
+func TestScanValue(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	ddl := `create table testing (
+		created_at datetime,
+		updated_at timestamp,
+		enabled boolean,
+		price decimal(10, 2),
+		attrs json,
+		data blob,
+		name text
+	)`
+	if _, err := db.Exec(ddl); err != nil {
+		panic(err)
+	}
+	stmts := []string{
+		`insert into testing values ('2018-06-01 12:00:00', 1527854400, 1, '12.5', '{"a":1}', x'0102', 'a')`,
+		`insert into testing values (null, null, null, null, null, null, null)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			panic(err)
+		}
+	}
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	cases := []struct {
+		Name    string
+		Dialect *Dialect
+		Expect  []map[string]interface{}
+	}{
+		{
+			"default",
+			&Dialect{},
+			[]map[string]interface{}{
+				{
+					"created_at": time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC),
+					"updated_at": time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC),
+					"enabled":    true,
+					"price":      "12.5",
+					"attrs":      json.RawMessage(`{"a":1}`),
+					"data":       []byte{1, 2},
+					"name":       "a",
+				},
+				{
+					"created_at": nil,
+					"updated_at": nil,
+					"enabled":    nil,
+					"price":      nil,
+					"attrs":      nil,
+					"data":       nil,
+					"name":       nil,
+				},
+			},
+		},
+		{
+			"with location",
+			&Dialect{
+				TimeLayouts: []string{"2006-01-02 15:04:05"},
+				Location:    jst,
+			},
+			[]map[string]interface{}{
+				{
+					"created_at": time.Date(2018, 6, 1, 21, 0, 0, 0, jst),
+					"updated_at": time.Date(2018, 6, 1, 21, 0, 0, 0, jst),
+				},
+			},
+		},
+	}
+	for i, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			name := fmt.Sprintf("sqlite3-testing-%d", i)
+			goen.Register(name, c.Dialect)
+			dbc := goen.NewDBContext(name, db)
+
+			rows, err := dbc.Query(`select * from testing order by rowid`)
+			if err != nil {
+				panic(err)
+			}
+			var records []map[string]interface{}
+			if !assert.NoError(t, dbc.Scan(rows, &records)) {
+				return
+			}
+			if !assert.Len(t, records, 2) {
+				return
+			}
+			for i, expect := range c.Expect {
+				for k, v := range expect {
+					assert.Equal(t, v, records[i][k], "row %d column %q", i, k)
+				}
+			}
+		})
+	}
+	t.Run("with time layouts", func(t *testing.T) {
+		goen.Register("sqlite3-testing-layouts", &Dialect{
+			TimeLayouts: []string{"2006/01/02 15:04:05"},
+			Location:    jst,
+		})
+		dbc := goen.NewDBContext("sqlite3-testing-layouts", db)
+
+		if _, err := db.Exec(`create table testing_layouts (logged_at datetime(3))`); err != nil {
+			panic(err)
+		}
+		if _, err := db.Exec(`insert into testing_layouts values ('2018/06/01 12:00:00')`); err != nil {
+			panic(err)
+		}
+
+		rows, err := dbc.Query(`select logged_at from testing_layouts`)
+		if err != nil {
+			panic(err)
+		}
+		var records [][]interface{}
+		if !assert.NoError(t, dbc.Scan(rows, &records)) {
+			return
+		}
+		assert.Equal(t, [][]interface{}{
+			{time.Date(2018, 6, 1, 21, 0, 0, 0, jst)},
+		}, records)
+	})
 }
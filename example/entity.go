@@ -15,6 +15,12 @@ type Blog struct {
 	Author string
 
 	Posts []*Post `foreign_key:"blog_id"`
+
+	// truncated into int
+	AvgOrder int `aggregate:"avg,Posts,order"`
+
+	// nil when no posts are deleted
+	LastDeletedAt *time.Time `aggregate:"max,Posts,deleted_at"`
 }
 
 type Post struct {
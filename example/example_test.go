@@ -115,7 +115,7 @@ func Example() {
 	// Output:
 	// all blogs = 3
 	// found blogs = 2
-	// (*example.Blog){BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 Name:(string)testing1 Author:(string)kamichidu Posts:([]*example.Post)[<max>]}
+	// (*example.Blog){BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 Name:(string)testing1 Author:(string)kamichidu Posts:([]*example.Post)[<max>] AvgOrder:(int)0 LastDeletedAt:(*time.Time)<nil>}
 	// - (*example.Post){Timestamp:(example.Timestamp){<max>} BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 PostID:(int)1 Title:(string)titleA Content:(string)contentA Order:(int)0 Blog:(*example.Blog){<max>}}
 	//   CreatedAt:"2018-06-01T12:00:00Z"
 	//   UpdatedAt:"2018-06-01T12:00:00Z"
@@ -124,7 +124,7 @@ func Example() {
 	//   CreatedAt:"2018-06-01T12:00:00Z"
 	//   UpdatedAt:"2018-06-01T12:00:00Z"
 	//   DeletedAt:nil
-	// (*example.Blog){BlogID:(uuid.UUID)b95e5d4d-7eb9-4612-882d-224daa4a59ee Name:(string)testing2 Author:(string)unknown Posts:([]*example.Post)<nil>}
+	// (*example.Blog){BlogID:(uuid.UUID)b95e5d4d-7eb9-4612-882d-224daa4a59ee Name:(string)testing2 Author:(string)unknown Posts:([]*example.Post)<nil> AvgOrder:(int)0 LastDeletedAt:(*time.Time)<nil>}
 }
 
 func Example_queryRow() {
@@ -210,7 +210,7 @@ func Example_queryRow() {
 	}
 	// Output:
 	// QueryRow returns sql.ErrNoRows when a record was not found.
-	// (*example.Blog){BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 Name:(string)testing1 Author:(string)kamichidu Posts:([]*example.Post)[<max>]}
+	// (*example.Blog){BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 Name:(string)testing1 Author:(string)kamichidu Posts:([]*example.Post)[<max>] AvgOrder:(int)0 LastDeletedAt:(*time.Time)<nil>}
 	// - (*example.Post){Timestamp:(example.Timestamp){<max>} BlogID:(uuid.UUID)d03bc237-eef4-4b6f-afe1-ea901357d828 PostID:(int)1 Title:(string)titleA Content:(string)contentA Order:(int)0 Blog:(*example.Blog){<max>}}
 	//   CreatedAt:"2018-06-01T12:00:00Z"
 	//   UpdatedAt:"2018-06-01T12:00:00Z"
@@ -408,12 +408,26 @@ func Example_nullableColumn() {
 		}
 	}
 
+	fmt.Println("print filtered rows that deleted at is not null, and before tomorrow")
+	posts, err = dbc.Post.Select().
+		Where(dbc.Post.DeletedAt.IsNotNull(), dbc.Post.DeletedAt.Lt(now.AddDate(0, 0, 1))).
+		OrderBy(dbc.Post.Title.Asc()).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Printf("%q > DeletedAt = %q\n", post.Title, post.DeletedAt.Format(time.RFC3339))
+	}
+
 	// Output:
 	// print all rows
 	// "p1" > DeletedAt = "2018-08-09T13:48:29Z"
 	// "p2" > DeletedAt = nil
 	// print filtered rows that deleted at is null
 	// "p2" > DeletedAt = nil
+	// print filtered rows that deleted at is not null, and before tomorrow
+	// "p1" > DeletedAt = "2018-08-09T13:48:29Z"
 }
 
 func Example_queryBuilderAsSqlizer() {
@@ -432,7 +446,7 @@ func Example_queryBuilderAsSqlizer() {
 	fmt.Printf("%q\n", args)
 
 	// Output:
-	// "SELECT `blog_id` FROM `blogs` WHERE `author` = ?"
+	// "SELECT `blog_id` FROM `blogs` WHERE `blogs`.`author` = ?"
 	// ["kamichidu"]
 }
 
@@ -486,3 +500,589 @@ func Example_brokenManyToOneRelationWithNonForeignKey() {
 	// "0 the valid post" with related blog "the blog"
 	// "1 the invalid post" with related blog "<nil>"
 }
+
+func Example_filteredInclude() {
+	dbc := NewDBContext(prepareDB())
+
+	blogIDs := []uuid.UUID{
+		uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828")),
+		uuid.Must(uuid.FromString("b95e5d4d-7eb9-4612-882d-224daa4a59ee")),
+	}
+	for i, blogID := range blogIDs {
+		dbc.Blog.Insert(&Blog{
+			BlogID: blogID,
+			Name:   fmt.Sprintf("blog-%d", i),
+		})
+		for j := 0; j < 4; j++ {
+			title := fmt.Sprintf("post-%d-%d", i, j)
+			if j == 3 {
+				title = "draft"
+			}
+			dbc.Post.Insert(&Post{
+				BlogID: blogID,
+				Title:  title,
+				Timestamp: Timestamp{
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				},
+			})
+		}
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// latest 2 posts except drafts for each blog
+	blogs, err := dbc.Blog.Select().
+		Include(
+			dbc.Blog.IncludePostsWhere(dbc.Post.Title.NotEq("draft")).
+				OrderBy(dbc.Post.PostID.Desc()).
+				LimitPerParent(2),
+			dbc.Post.IncludeBlog).
+		OrderBy(dbc.Blog.Name.Asc()).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, blog := range blogs {
+		fmt.Printf("%s\n", blog.Name)
+		for _, post := range blog.Posts {
+			fmt.Printf("- %s in %s\n", post.Title, post.Blog.Name)
+		}
+	}
+	// Output:
+	// blog-0
+	// - post-0-2 in blog-0
+	// - post-0-1 in blog-0
+	// blog-1
+	// - post-1-2 in blog-1
+	// - post-1-1 in blog-1
+}
+
+func Example_aggregate() {
+	dbc := NewDBContext(prepareDB())
+
+	blogs := []*Blog{
+		&Blog{
+			BlogID: uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828")),
+			Name:   "blog-0",
+		},
+		&Blog{
+			BlogID: uuid.Must(uuid.FromString("b95e5d4d-7eb9-4612-882d-224daa4a59ee")),
+			Name:   "blog-1",
+		},
+	}
+	for _, blog := range blogs {
+		dbc.Blog.Insert(blog)
+	}
+	for i := 0; i < 3; i++ {
+		dbc.Post.Insert(&Post{
+			BlogID: blogs[0].BlogID,
+			Title:  fmt.Sprintf("post-%d", i),
+			Order:  1 << uint(i),
+			Timestamp: Timestamp{
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		})
+	}
+	deletedAt := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	dbc.Post.Insert(&Post{
+		BlogID: blogs[1].BlogID,
+		Title:  "post-3",
+		Order:  1,
+		Timestamp: Timestamp{
+			DeletedAt: &deletedAt,
+		},
+	})
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// counting posts of blogs without loading them
+	counts, err := dbc.Blog.CountPosts().Query(blogs)
+	if err != nil {
+		panic(err)
+	}
+	for _, blog := range blogs {
+		fmt.Printf("%s has %d posts\n", blog.Name, counts[blog.BlogID])
+	}
+
+	// an average into int is truncated, max of NULLs is nil
+	blogs, err = dbc.Blog.Select().
+		Include(dbc.Blog.AvgPostsOrder(), dbc.Blog.MaxPostsDeletedAt()).
+		OrderBy(dbc.Blog.Name.Asc()).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, blog := range blogs {
+		if blog.LastDeletedAt != nil {
+			fmt.Printf("%s: avg order %d, last deleted at %s\n", blog.Name, blog.AvgOrder, blog.LastDeletedAt.Format(time.RFC3339))
+		} else {
+			fmt.Printf("%s: avg order %d, no deleted posts\n", blog.Name, blog.AvgOrder)
+		}
+	}
+	// Output:
+	// blog-0 has 3 posts
+	// blog-1 has 1 posts
+	// blog-0: avg order 2, no deleted posts
+	// blog-1: avg order 1, last deleted at 2018-06-01T12:00:00Z
+}
+
+func Example_columns() {
+	dbc := NewDBContext(prepareDB())
+
+	blogID := uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828"))
+	dbc.Blog.Insert(&Blog{
+		BlogID: blogID,
+		Name:   "testing",
+	})
+	dbc.Post.Insert(&Post{
+		BlogID:  blogID,
+		Title:   "title",
+		Content: "a large content",
+		Timestamp: Timestamp{
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	})
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// key columns are selected for includes
+	post, err := dbc.Post.Select().
+		Columns(dbc.Post.Title).
+		Include(dbc.Post.IncludeBlog).
+		QueryRow()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("title=%q content=%q blog=%q\n", post.Title, post.Content, post.Blog.Name)
+
+	// partial row updates only selected columns, even by a copy
+	copied := *post
+	copied.Title = "updated"
+	dbc.Post.Update(&copied)
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+	post, err = dbc.Post.Select().QueryRow()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("title=%q content=%q\n", post.Title, post.Content)
+	// Output:
+	// title="title" content="" blog="testing"
+	// title="updated" content="a large content"
+}
+
+func Example_join() {
+	dbc := NewDBContext(prepareDB())
+
+	blogIDs := map[string]uuid.UUID{}
+	for _, name := range []string{"golang", "rust"} {
+		blog := &Blog{
+			BlogID: uuid.Must(uuid.NewV4()),
+			Name:   name,
+		}
+		blogIDs[name] = blog.BlogID
+		dbc.Blog.Insert(blog)
+		for _, title := range []string{"first", "second"} {
+			dbc.Post.Insert(&Post{
+				BlogID: blog.BlogID,
+				Title:  name + " " + title,
+				Timestamp: Timestamp{
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				},
+			})
+		}
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// joined columns are typed, but the result set is scanned into Post only
+	q := dbc.Post.Select().JoinBlog()
+	q = q.Where(q.Blog.Name.Eq("golang")).OrderBy(q.Blog.Name.Asc(), dbc.Post.Title.Desc())
+	posts, err := q.Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Printf("title=%q blog=%v\n", post.Title, post.Blog)
+	}
+	count, err := q.Count()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("count=%d\n", count)
+
+	// columns of Post are qualified by the table name, then blog_id is not ambiguous with blogs
+	q = dbc.Post.Select().JoinBlog()
+	q = q.Where(dbc.Post.BlogID.Eq(blogIDs["rust"])).OrderBy(dbc.Post.Title.Asc())
+	posts, err = q.Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Printf("title=%q\n", post.Title)
+	}
+	// Output:
+	// title="golang second" blog=<nil>
+	// title="golang first" blog=<nil>
+	// count=2
+	// title="rust first"
+	// title="rust second"
+}
+
+func Example_subquery() {
+	dbc := NewDBContext(prepareDB())
+
+	for _, name := range []string{"golang", "rust", "empty"} {
+		blog := &Blog{
+			BlogID: uuid.Must(uuid.NewV4()),
+			Name:   name,
+			Author: "kamichidu",
+		}
+		dbc.Blog.Insert(blog)
+		if name == "empty" {
+			continue
+		}
+		dbc.Post.Insert(&Post{
+			BlogID: blog.BlogID,
+			Title:  "hello " + name,
+			Timestamp: Timestamp{
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		})
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	printBlogs := func(label string, cond BlogSqlizer) {
+		blogs, err := dbc.Blog.Select().Where(cond).OrderBy(dbc.Blog.Name.Asc()).Query()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s:", label)
+		for _, blog := range blogs {
+			fmt.Printf(" %s", blog.Name)
+		}
+		fmt.Println()
+	}
+	printBlogs("has post", dbc.Blog.WhereHasPosts(dbc.Post.Title.Eq("hello golang")))
+	printBlogs("has no posts", dbc.Blog.WhereHasNoPosts())
+
+	posts, err := dbc.Post.Select().
+		Where(dbc.Post.BlogID.InQuery(
+			dbc.Blog.Select().
+				Where(dbc.Blog.Name.Eq("rust")).
+				ToSqlizer(dbc.Blog.BlogID.QuotedString()))).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Printf("post: %s\n", post.Title)
+	}
+	// Output:
+	// has post: golang
+	// has no posts: empty
+	// post: hello rust
+}
+
+func Example_aggregation() {
+	dbc := NewDBContext(prepareDB())
+
+	createdAt := time.Date(2018, 8, 9, 13, 48, 29, 0, time.UTC)
+	blogIDs := []uuid.UUID{
+		uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828")),
+		uuid.Must(uuid.FromString("b95e5d4d-7eb9-4612-882d-224daa4a59ee")),
+	}
+	for i, blogID := range blogIDs {
+		dbc.Blog.Insert(&Blog{
+			BlogID: blogID,
+			Name:   fmt.Sprintf("blog%d", i),
+		})
+		for j := 0; j <= i; j++ {
+			dbc.Post.Insert(&Post{
+				BlogID: blogID,
+				Title:  fmt.Sprintf("post%d-%d", i, j),
+				Order:  (i + 1) * (j + 1),
+				Timestamp: Timestamp{
+					CreatedAt: createdAt.AddDate(0, 0, i+j),
+					UpdatedAt: createdAt,
+				},
+			})
+		}
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// aggregated values are typed by the column
+	q := dbc.Post.Select()
+	sum, err := dbc.Post.Order.Sum(q)
+	if err != nil {
+		panic(err)
+	}
+	avg, err := dbc.Post.Order.Avg(q)
+	if err != nil {
+		panic(err)
+	}
+	max, err := dbc.Post.Order.Max(q)
+	if err != nil {
+		panic(err)
+	}
+	latest, err := dbc.Post.CreatedAt.Max(q)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("sum=%d avg=%.2f max=%d latest=%s\n", sum, avg, max, latest.UTC().Format("2006-01-02"))
+
+	// grouped rows are scanned into structs
+	var stats []struct {
+		BlogID   uuid.UUID
+		Count    int64
+		SumOrder int64
+	}
+	err = dbc.Post.Select().
+		GroupBy(dbc.Post.BlogID).
+		OrderBy(dbc.Post.CountExpr().Asc()).
+		ScanGroups(&stats, dbc.Post.CountExpr(), dbc.Post.Order.SumExpr())
+	if err != nil {
+		panic(err)
+	}
+	for _, stat := range stats {
+		fmt.Printf("blog_id=%s count=%d sum_order=%d\n", stat.BlogID, stat.Count, stat.SumOrder)
+	}
+
+	// or maps, and groups are filtered by having
+	var rows []map[string]interface{}
+	err = dbc.Post.Select().
+		GroupBy(dbc.Post.BlogID).
+		Having(dbc.Post.CountExpr().Gt(1)).
+		ScanGroups(&rows, dbc.Post.Order.MaxExpr().As("top"))
+	if err != nil {
+		panic(err)
+	}
+	for _, row := range rows {
+		fmt.Printf("top=%v\n", row["top"])
+	}
+	// Output:
+	// sum=7 avg=2.33 max=4 latest=2018-08-11
+	// blog_id=d03bc237-eef4-4b6f-afe1-ea901357d828 count=1 sum_order=1
+	// blog_id=b95e5d4d-7eb9-4612-882d-224daa4a59ee count=2 sum_order=6
+	// top=4
+}
+
+func Example_conditions() {
+	dbc := NewDBContext(prepareDB())
+
+	blogID := uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828"))
+	dbc.Blog.Insert(&Blog{
+		BlogID: blogID,
+		Name:   "testing",
+	})
+	for i, title := range []string{"a", "b", "c", "d"} {
+		dbc.Post.Insert(&Post{
+			BlogID: blogID,
+			Title:  title,
+			Order:  i,
+			Timestamp: Timestamp{
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		})
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// (title = "a" OR order >= 2) AND NOT title = "d"
+	posts, err := dbc.Post.Select().
+		Where(
+			dbc.Post.Or(dbc.Post.Title.Eq("a"), dbc.Post.Order.GtOrEq(2)),
+			dbc.Post.Not(dbc.Post.Title.Eq("d")),
+		).
+		OrderBy(dbc.Post.Title.Asc()).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Println(post.Title)
+	}
+	// Output:
+	// a
+	// c
+}
+
+func Example_paginate() {
+	dbc := NewDBContext(prepareDB())
+	dbc.CursorKey = []byte("secret")
+
+	blogID := uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828"))
+	dbc.Blog.Insert(&Blog{
+		BlogID: blogID,
+		Name:   "testing",
+	})
+	for i, title := range []string{"a", "b", "c", "d", "e"} {
+		dbc.Post.Insert(&Post{
+			BlogID: blogID,
+			Title:  title,
+			Order:  i / 2,
+			Timestamp: Timestamp{
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		})
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	printPage := func(page *PostPage) {
+		for _, post := range page.Records {
+			fmt.Printf(" %s(%d)", post.Title, post.Order)
+		}
+		fmt.Printf(" next=%v prev=%v\n", page.Next != "", page.Prev != "")
+	}
+
+	// mixed directions, post_id is appended as a tie-breaker
+	orderBys := []PostOrderExpr{dbc.Post.Order.Desc(), dbc.Post.Title.Asc()}
+	var cursor goen.Cursor
+	for {
+		page, err := dbc.Post.Select().Paginate(cursor, 2, orderBys...)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Print("page:")
+		printPage(page)
+		if page.Next == "" {
+			cursor = page.Prev
+			break
+		}
+		cursor = page.Next
+	}
+	page, err := dbc.Post.Select().Paginate(cursor, 2, orderBys...)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print("back:")
+	printPage(page)
+	// Output:
+	// page: e(2) c(1) next=true prev=false
+	// page: d(1) a(0) next=true prev=true
+	// page: b(0) next=false prev=true
+	// back: d(1) a(0) next=true prev=true
+}
+
+func Example_rowLock() {
+	dbc := NewDBContext(prepareDB())
+
+	_, err := dbc.Blog.Select().ForUpdate().Query()
+	fmt.Println(err)
+
+	tx, err := dbc.DB.Begin()
+	if err != nil {
+		panic(err)
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	// sqlite3 locks the whole database instead of rows
+	_, err = dbc.UseTx(tx).Blog.Select().ForUpdate().SkipLocked().Query()
+	fmt.Println(err)
+
+	// aggregates can't lock rows
+	_, err = dbc.UseTx(tx).Blog.Select().ForUpdate().Count()
+	fmt.Println(err)
+	// Output:
+	// goen: row-locking requires a transaction, use DBContext.UseTx
+	// goen: sqlite3 does not support row-locking clauses
+	// goen: row-locking is not supported by Count
+}
+
+func Example_updateAll() {
+	dbc := NewDBContext(prepareDB())
+
+	blogID := uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828"))
+	dbc.Blog.Insert(&Blog{
+		BlogID: blogID,
+		Name:   "testing",
+	})
+	for i, title := range []string{"a", "b", "c", "d"} {
+		dbc.Post.Insert(&Post{
+			BlogID: blogID,
+			Title:  title,
+			Order:  i,
+			Timestamp: Timestamp{
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		})
+	}
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	// statements are buffered, and executed in order by SaveChanges
+	archived := dbc.Post.Select().
+		Where(dbc.Post.Order.Lt(2)).
+		UpdateAll(dbc.Post.Title.Set("archived"), dbc.Post.Order.Add(10))
+	deleted := dbc.Post.Select().
+		Where(dbc.Post.Order.GtOrEq(3), dbc.Post.Order.Lt(10)).
+		DeleteAll()
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+	fmt.Printf("archived %d posts, deleted %d posts\n", archived.RowsAffected, deleted.RowsAffected)
+
+	posts, err := dbc.Post.Select().
+		OrderBy(dbc.Post.Order.Asc()).
+		Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, post := range posts {
+		fmt.Printf("%s(%d)\n", post.Title, post.Order)
+	}
+	// Output:
+	// archived 2 posts, deleted 1 posts
+	// c(2)
+	// archived(10)
+	// archived(11)
+}
+
+func Example_upsert() {
+	dbc := NewDBContext(prepareDB())
+
+	blogID := uuid.Must(uuid.FromString("d03bc237-eef4-4b6f-afe1-ea901357d828"))
+	dbc.Blog.Upsert(&Blog{
+		BlogID: blogID,
+		Name:   "first",
+	})
+	// conflicts by the primary key
+	dbc.Blog.Upsert(&Blog{
+		BlogID: blogID,
+		Name:   "second",
+	})
+	if err := dbc.SaveChanges(); err != nil {
+		panic(err)
+	}
+
+	blogs, err := dbc.Blog.Select().Query()
+	if err != nil {
+		panic(err)
+	}
+	for _, blog := range blogs {
+		fmt.Println(blog.Name)
+	}
+	// Output:
+	// second
+}
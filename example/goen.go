@@ -21,7 +21,7 @@ func init() {
 }
 
 type BlogSqlizer interface {
-	squirrel.Sqlizer
+	goen.EntitySqlizer
 
 	BlogToSql() (string, []interface{}, error)
 }
@@ -34,6 +34,11 @@ func (sqlizer *_BlogSqlizer) BlogToSql() (string, []interface{}, error) {
 	return sqlizer.ToSql()
 }
 
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_BlogSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
 type BlogColumnExpr interface {
 	BlogColumnExpr() string
 
@@ -44,11 +49,116 @@ type BlogOrderExpr interface {
 	BlogOrderExpr() string
 }
 
+type BlogAssignment interface {
+	// BlogAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	BlogAssignment() (column string, value interface{})
+}
+
+type _BlogAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _BlogAssignment) BlogAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type BlogAggregateExpr interface {
+	// BlogAggregateExpr gets an aggregate function call and its result column name.
+	BlogAggregateExpr() (expr string, name string)
+}
+
+type _BlogAggregateExpr struct {
+	expr string
+	name string
+}
+
+// BlogAggregateExpr implements BlogAggregateExpr.
+func (e _BlogAggregateExpr) BlogAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _BlogAggregateExpr) As(name string) _BlogAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _BlogAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _BlogAggregateExpr) Eq(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) NotEq(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) Lt(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) LtOrEq(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) Gt(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) GtOrEq(v interface{}) BlogSqlizer {
+	return &_BlogSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _BlogAggregateExpr) Asc() BlogOrderExpr {
+	return _BlogAggregateOrderExpr(e.expr)
+}
+
+func (e _BlogAggregateExpr) Desc() BlogOrderExpr {
+	return _BlogAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _BlogAggregateOrderExpr string
+
+func (s _BlogAggregateOrderExpr) BlogOrderExpr() string {
+	return string(s)
+}
+
+// BlogPage is a page of keyset pagination.
+type BlogPage struct {
+	Records []*Blog
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
 type BlogQueryBuilder struct {
 	dbc *goen.DBContext
 
 	includeLoaders goen.IncludeLoaderList
 
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
 	builder squirrel.SelectBuilder
 }
 
@@ -68,26 +178,32 @@ func (qb BlogQueryBuilder) Include(loaders ...goen.IncludeLoader) BlogQueryBuild
 }
 
 func (qb BlogQueryBuilder) Where(conds ...BlogSqlizer) BlogQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
 	for _, cond := range conds {
 		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
 	}
 	return qb
 }
 
 func (qb BlogQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) BlogQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
 	for _, cond := range conds {
 		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
 	}
 	return qb
 }
 
 func (qb BlogQueryBuilder) Offset(offset uint64) BlogQueryBuilder {
 	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
 	return qb
 }
 
 func (qb BlogQueryBuilder) Limit(limit uint64) BlogQueryBuilder {
 	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
 	return qb
 }
 
@@ -97,14 +213,206 @@ func (qb BlogQueryBuilder) OrderBy(orderBys ...BlogOrderExpr) BlogQueryBuilder {
 		exprs[i] = orderBys[i].BlogOrderExpr()
 	}
 	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb BlogQueryBuilder) Columns(cols ...BlogColumnExpr) BlogQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb BlogQueryBuilder) ForUpdate() BlogQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
+
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb BlogQueryBuilder) ForShare() BlogQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb BlogQueryBuilder) SkipLocked() BlogQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
+}
+
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb BlogQueryBuilder) NoWait() BlogQueryBuilder {
+	qb.lock = qb.lock.NoWait()
+	return qb
+}
+
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb BlogQueryBuilder) GroupBy(cols ...BlogColumnExpr) BlogQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
+	return qb
+}
+
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb BlogQueryBuilder) Having(conds ...BlogSqlizer) BlogQueryBuilder {
+	for _, cond := range conds {
+		qb.builder = qb.builder.Having(cond)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
 	return qb
 }
 
+func (qb BlogQueryBuilder) ScanGroups(v interface{}, aggrs ...BlogAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
+}
+
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb BlogQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...BlogAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.BlogAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
+}
+
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb BlogQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb BlogQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...BlogOrderExpr) (*BlogPage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Blog, and qb must not be ordered or limited by itself.
+func (qb BlogQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...BlogOrderExpr) (*BlogPage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
+	for i := range orderBys {
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Blog{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &BlogPage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb BlogQueryBuilder) UpdateAll(sets ...BlogAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Blog{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].BlogAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb BlogQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Blog{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb BlogQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb BlogQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
 func (qb BlogQueryBuilder) Count() (int64, error) {
 	return qb.CountContext(context.Background())
 }
 
 func (qb BlogQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
 	query, args, err := qb.builder.Columns("count(*)").ToSql()
 	if err != nil {
 		return 0, err
@@ -142,14 +450,22 @@ func (qb BlogQueryBuilder) QueryRowContext(ctx context.Context) (*Blog, error) {
 }
 
 func (qb BlogQueryBuilder) query(ctx context.Context) ([]*Blog, error) {
-	// for caching reason, wont support filtering columns
-	metaT := metaSchema.LoadOf(&Blog{})
-	cols := make([]string, len(metaT.Columns()))
-	for i := range metaT.Columns() {
-		cols[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
 	}
 
-	query, args, err := qb.builder.Columns(cols...).ToSql()
+	var lockTables []string
+	if qb.joined {
+		// only rows of blogs are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("blogs"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +481,15 @@ func (qb BlogQueryBuilder) query(ctx context.Context) ([]*Blog, error) {
 	}
 	rows.Close()
 
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
 	sc := goen.NewScopeCache(metaSchema)
 	for _, record := range records {
 		sc.AddObject(record)
@@ -176,29 +501,79 @@ func (qb BlogQueryBuilder) query(ctx context.Context) ([]*Blog, error) {
 	return records, nil
 }
 
+// columnNames gets column names to be selected in table order.
+func (qb BlogQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Blog{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+		selected["blog_id"] = true
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb BlogQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Blog{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
 // ToSqlizer returns Sqlizer that built by BlogQueryBuilder with given columns.
 // The columns defaults to all columns of Blog, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
 func (qb BlogQueryBuilder) ToSqlizer(columns ...string) BlogSqlizer {
 	if len(columns) == 0 {
 		metaT := metaSchema.LoadOf(&Blog{})
 		columns = make([]string, len(metaT.Columns()))
 		for i := range metaT.Columns() {
-			columns[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
 		}
 	}
 	// only return, not to set qb.builder.
 	return &_BlogSqlizer{qb.builder.Columns(columns...)}
 }
 
-type _Blog_BlogID_OrderExpr string
+type _Blog_BlogID_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Blog_BlogID_OrderExpr) BlogOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Blog_BlogID_OrderExpr) CursorOrder() (string, bool) {
+	return "blog_id", s.desc
 }
 
 type _Blog_BlogID struct {
 	bs string
 	qs string
+	es string
 }
 
 // BlogColumnExpr implements _Blog_BlogID_OrderExpr.
@@ -216,71 +591,102 @@ func (c _Blog_BlogID) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Blog_BlogID) expr() string {
+	return c.es
+}
+
 func (c _Blog_BlogID) Eq(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) NotEq(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) In(v ...github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_BlogID) NotIn(v ...github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_BlogID) Like(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_BlogID) NotLike(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_BlogID) Lt(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) LtOrEq(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) Gt(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) GtOrEq(v github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_BlogID) Between(v1, v2 github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Blog_BlogID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Blog_BlogID) InQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Blog_BlogID) NotInQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Blog_BlogID) Set(v github_com_satori_go_uuid.UUID) BlogAssignment {
+	return _BlogAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Blog_BlogID) Asc() BlogOrderExpr {
-	return _Blog_BlogID_OrderExpr(c.QuotedString())
+	return _Blog_BlogID_OrderExpr{c.expr(), false}
 }
 
 func (c _Blog_BlogID) Desc() BlogOrderExpr {
-	return _Blog_BlogID_OrderExpr(c.QuotedString() + " DESC")
+	return _Blog_BlogID_OrderExpr{c.expr(), true}
 }
 
-type _Blog_Name_OrderExpr string
+type _Blog_Name_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Blog_Name_OrderExpr) BlogOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Blog_Name_OrderExpr) CursorOrder() (string, bool) {
+	return "name", s.desc
 }
 
 type _Blog_Name struct {
 	bs string
 	qs string
+	es string
 }
 
 // BlogColumnExpr implements _Blog_Name_OrderExpr.
@@ -298,71 +704,102 @@ func (c _Blog_Name) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Blog_Name) expr() string {
+	return c.es
+}
+
 func (c _Blog_Name) Eq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) NotEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) In(v ...string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_Name) NotIn(v ...string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_Name) Like(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_Name) NotLike(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_Name) Lt(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) LtOrEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) Gt(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) GtOrEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Name) Between(v1, v2 string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Blog_Name) NotBetween(v1, v2 string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Blog_Name) InQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Blog_Name) NotInQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Blog_Name) Set(v string) BlogAssignment {
+	return _BlogAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Blog_Name) Asc() BlogOrderExpr {
-	return _Blog_Name_OrderExpr(c.QuotedString())
+	return _Blog_Name_OrderExpr{c.expr(), false}
 }
 
 func (c _Blog_Name) Desc() BlogOrderExpr {
-	return _Blog_Name_OrderExpr(c.QuotedString() + " DESC")
+	return _Blog_Name_OrderExpr{c.expr(), true}
 }
 
-type _Blog_Author_OrderExpr string
+type _Blog_Author_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Blog_Author_OrderExpr) BlogOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Blog_Author_OrderExpr) CursorOrder() (string, bool) {
+	return "author", s.desc
 }
 
 type _Blog_Author struct {
 	bs string
 	qs string
+	es string
 }
 
 // BlogColumnExpr implements _Blog_Author_OrderExpr.
@@ -380,60 +817,79 @@ func (c _Blog_Author) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Blog_Author) expr() string {
+	return c.es
+}
+
 func (c _Blog_Author) Eq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) NotEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) In(v ...string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_Author) NotIn(v ...string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Blog_Author) Like(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_Author) NotLike(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Blog_Author) Lt(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) LtOrEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) Gt(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) GtOrEq(v string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_BlogSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Blog_Author) Between(v1, v2 string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Blog_Author) NotBetween(v1, v2 string) BlogSqlizer {
-	return &_BlogSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_BlogSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Blog_Author) InQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Blog_Author) NotInQuery(q goen.EntitySqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Blog_Author) Set(v string) BlogAssignment {
+	return _BlogAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Blog_Author) Asc() BlogOrderExpr {
-	return _Blog_Author_OrderExpr(c.QuotedString())
+	return _Blog_Author_OrderExpr{c.expr(), false}
 }
 
 func (c _Blog_Author) Desc() BlogOrderExpr {
-	return _Blog_Author_OrderExpr(c.QuotedString() + " DESC")
+	return _Blog_Author_OrderExpr{c.expr(), true}
 }
 
 type BlogDBSet struct {
@@ -450,11 +906,12 @@ func newBlogDBSet(dbc *goen.DBContext) *BlogDBSet {
 	dbset := &BlogDBSet{
 		dbc: dbc,
 	}
-	dbset.BlogID = _Blog_BlogID{"blog_id", dbc.Dialect().Quote("blog_id")}
-	dbset.Name = _Blog_Name{"name", dbc.Dialect().Quote("name")}
-	dbset.Author = _Blog_Author{"author", dbc.Dialect().Quote("author")}
+	table := dbc.Dialect().Quote("blogs")
+	dbset.BlogID = _Blog_BlogID{"blog_id", dbc.Dialect().Quote("blog_id"), table + "." + dbc.Dialect().Quote("blog_id")}
+	dbset.Name = _Blog_Name{"name", dbc.Dialect().Quote("name"), table + "." + dbc.Dialect().Quote("name")}
+	dbset.Author = _Blog_Author{"author", dbc.Dialect().Quote("author"), table + "." + dbc.Dialect().Quote("author")}
 
-	dbset.IncludePosts = goen.IncludeLoaderFunc(dbset.includePosts)
+	dbset.IncludePosts = goen.IncludeFetcherFunc(dbset.fetchPosts)
 
 	return dbset
 }
@@ -473,6 +930,43 @@ func (dbset *BlogDBSet) Insert(v *Blog) {
 	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
 }
 
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *BlogDBSet) Upsert(v *Blog, conflictCols ...BlogColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
+}
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *BlogDBSet) Or(conds ...BlogSqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.Or(dbset.sqlizers(conds)...)}
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *BlogDBSet) And(conds ...BlogSqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.And(dbset.sqlizers(conds)...)}
+}
+
+// Not makes a condition whether cond is false.
+func (dbset *BlogDBSet) Not(cond BlogSqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.Not(cond)}
+}
+
+func (dbset *BlogDBSet) sqlizers(conds []BlogSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *BlogDBSet) CountExpr() _BlogAggregateExpr {
+	return _BlogAggregateExpr{"COUNT(*)", "count"}
+}
+
 func (dbset *BlogDBSet) Select() BlogQueryBuilder {
 	return newBlogQueryBuilder(dbset.dbc)
 }
@@ -485,10 +979,10 @@ func (dbset *BlogDBSet) Delete(v *Blog) {
 	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
 }
 
-func (dbset *BlogDBSet) includePosts(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+func (dbset *BlogDBSet) fetchPosts(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
 	entities, ok := records.([]*Blog)
 	if !ok {
-		return nil
+		return func() {}, nil
 	}
 
 	childRowKeyOf := func(v *Blog) goen.RowKey {
@@ -512,35 +1006,34 @@ func (dbset *BlogDBSet) includePosts(ctx context.Context, later *goen.IncludeBuf
 		}
 	}
 	if len(noCachedChildRowKeys) > 0 {
-		cond := squirrel.Or{}
-		for _, rowKey := range noCachedChildRowKeys {
-			cond = append(cond, rowKey)
-		}
+		var noCachedEntities []*Post
 		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
-		query, args, err := stmtBuilder.Select(
-			dbset.dbc.Dialect().Quote("created_at"),
-			dbset.dbc.Dialect().Quote("updated_at"),
-			dbset.dbc.Dialect().Quote("deleted_at"),
-			dbset.dbc.Dialect().Quote("blog_id"),
-			dbset.dbc.Dialect().Quote("post_id"),
-			dbset.dbc.Dialect().Quote("title"),
-			dbset.dbc.Dialect().Quote("content"),
-			dbset.dbc.Dialect().Quote("order"),
-		).From(dbset.dbc.Dialect().Quote("posts")).Where(cond).ToSql()
-		if err != nil {
-			return err
-		}
-		rows, err := dbset.dbc.QueryContext(ctx, query, args...)
-		if err != nil {
-			return err
-		}
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("created_at"),
+				dbset.dbc.Dialect().Quote("updated_at"),
+				dbset.dbc.Dialect().Quote("deleted_at"),
+				dbset.dbc.Dialect().Quote("blog_id"),
+				dbset.dbc.Dialect().Quote("post_id"),
+				dbset.dbc.Dialect().Quote("title"),
+				dbset.dbc.Dialect().Quote("content"),
+				dbset.dbc.Dialect().Quote("order"),
+			).From(dbset.dbc.Dialect().Quote("posts")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
 
-		var noCachedEntities []*Post
-		if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
 			rows.Close()
-			return err
 		}
-		rows.Close()
 
 		for _, entity := range noCachedEntities {
 			sc.AddObject(entity)
@@ -550,847 +1043,2551 @@ func (dbset *BlogDBSet) includePosts(ctx context.Context, later *goen.IncludeBuf
 		later.AddRecords(noCachedEntities)
 	}
 
-	for _, entity := range entities {
-		childRowKey := childRowKeyOf(entity)
-		raw := sc.GetObject(goen.CardinalityOneToMany, childRowKey)
-		if refes, ok := raw.([]interface{}); ok {
-			for _, refe := range refes {
-				entity.Posts = append(entity.Posts, refe.(*Post))
+	return func() {
+		for _, entity := range entities {
+			childRowKey := childRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityOneToMany, childRowKey)
+			if refes, ok := raw.([]interface{}); ok {
+				// replaces rather than appends, the same field may be included by several loaders
+				children := make([]*Post, len(refes))
+				for i, refe := range refes {
+					children[i] = refe.(*Post)
+				}
+				entity.Posts = children
 			}
 		}
-	}
-
-	return nil
+	}, nil
 }
 
-func init() {
-	metaSchema.Register(Post{})
-}
+// _Blog_Posts_IncludeLoader loads Posts filtered by given conditions.
+// Loaded collections are not shared with other loaders, since they may be partial.
+type _Blog_Posts_IncludeLoader struct {
+	dbset *BlogDBSet
 
-type PostSqlizer interface {
-	squirrel.Sqlizer
+	conds []PostSqlizer
 
-	PostToSql() (string, []interface{}, error)
-}
+	orderBys []PostOrderExpr
 
-type _PostSqlizer struct {
-	squirrel.Sqlizer
+	limit uint64
 }
 
-func (sqlizer *_PostSqlizer) PostToSql() (string, []interface{}, error) {
-	return sqlizer.ToSql()
+// IncludePostsWhere returns an include loader for Posts filtered by conds.
+func (dbset *BlogDBSet) IncludePostsWhere(conds ...PostSqlizer) _Blog_Posts_IncludeLoader {
+	return _Blog_Posts_IncludeLoader{dbset: dbset, conds: conds}
 }
 
-type PostColumnExpr interface {
-	PostColumnExpr() string
-
-	String() string
+func (l _Blog_Posts_IncludeLoader) OrderBy(orderBys ...PostOrderExpr) _Blog_Posts_IncludeLoader {
+	l.orderBys = append(l.orderBys[:len(l.orderBys):len(l.orderBys)], orderBys...)
+	return l
 }
 
-type PostOrderExpr interface {
-	PostOrderExpr() string
+// LimitPerParent limits loaded Posts to n entities for each Blog.
+func (l _Blog_Posts_IncludeLoader) LimitPerParent(n uint64) _Blog_Posts_IncludeLoader {
+	l.limit = n
+	return l
 }
 
-type PostQueryBuilder struct {
-	dbc *goen.DBContext
-
-	includeLoaders goen.IncludeLoaderList
-
-	builder squirrel.SelectBuilder
+func (l _Blog_Posts_IncludeLoader) Load(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+	assign, err := l.Fetch(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
 }
 
-func newPostQueryBuilder(dbc *goen.DBContext) PostQueryBuilder {
-	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
-	metaT := metaSchema.LoadOf(&Post{})
-	return PostQueryBuilder{
+func (l _Blog_Posts_IncludeLoader) Fetch(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Blog)
+	if !ok || len(entities) == 0 {
+		return func() {}, nil
+	}
+
+	childRowKeyOf := func(v *Blog) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "posts",
+			Key: map[string]interface{}{
+				"blog_id": v.BlogID,
+			},
+		}
+	}
+
+	parentRowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		parentRowKeys[i] = childRowKeyOf(entity)
+	}
+	orderBys := make([]string, len(l.orderBys))
+	for i := range l.orderBys {
+		orderBys[i] = l.orderBys[i].PostOrderExpr()
+	}
+	// bind parameters other than parent row keys
+	params := 0
+	if l.limit > 0 {
+		params++
+	}
+	for _, c := range l.conds {
+		_, args, err := c.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		params += len(args)
+	}
+	var (
+		children []*Post
+		limited  bool
+	)
+	for _, parentCond := range l.dbset.dbc.RowKeyConditionsWithParams(parentRowKeys, params) {
+		cond := squirrel.And{parentCond}
+		for _, c := range l.conds {
+			cond = append(cond, c)
+		}
+		var builder squirrel.SelectBuilder
+		builder, limited = goen.SelectPerParent(
+			l.dbset.dbc.Dialect(),
+			"posts",
+			[]string{
+				"created_at",
+				"updated_at",
+				"deleted_at",
+				"blog_id",
+				"post_id",
+				"title",
+				"content",
+				"order",
+			},
+			cond,
+			orderBys,
+			[]string{
+				"blog_id",
+			},
+			l.limit)
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to children for each chunk
+		if err := l.dbset.dbc.Scan(rows, &children); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	// partial collections must not be visible through the shared sc
+	partial := goen.NewScopeCache(metaSchema)
+	for _, child := range children {
+		partial.AddObject(child)
+	}
+
+	// for newly loaded entity, to be filled by includeLoader
+	later.AddRecords(children)
+
+	return func() {
+		for _, entity := range entities {
+			raw := partial.GetObject(goen.CardinalityOneToMany, childRowKeyOf(entity))
+			if refes, ok := raw.([]interface{}); ok {
+				// replaces rather than appends, the same field may be included by several loaders
+				children := make([]*Post, 0, len(refes))
+				for i, refe := range refes {
+					if !limited && uint64(i) >= l.limit {
+						break
+					}
+					children = append(children, refe.(*Post))
+				}
+				entity.Posts = children
+			}
+		}
+	}, nil
+}
+
+func (dbset *BlogDBSet) relationPosts() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "blogs",
+		ForeignKeys: []string{
+			"blog_id",
+		},
+		RefeTable: "posts",
+		References: []string{
+			"blog_id",
+		},
+	}
+}
+
+// WhereHasPosts makes a condition whether the entity has any Posts matching conds, by a correlated EXISTS subquery.
+func (dbset *BlogDBSet) WhereHasPosts(conds ...PostSqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationPosts(), dbset.sqlizersPosts(conds))}
+}
+
+// WhereHasNoPosts makes a condition whether the entity has no Posts matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *BlogDBSet) WhereHasNoPosts(conds ...PostSqlizer) BlogSqlizer {
+	return &_BlogSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationPosts(), dbset.sqlizersPosts(conds))}
+}
+
+func (dbset *BlogDBSet) sqlizersPosts(conds []PostSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+type _Blog_CountPosts_AggregateRow struct {
+	Key0  github_com_satori_go_uuid.UUID  `column:"blog_id"`
+	Value _Blog_CountPosts_AggregateValue `column:"goen_aggregate"`
+}
+
+// _Blog_CountPosts_AggregateValue scans an aggregated value by goen.AggregateScanner, it's left zero for NULL.
+type _Blog_CountPosts_AggregateValue struct {
+	v int64
+}
+
+func (v *_Blog_CountPosts_AggregateValue) Scan(src interface{}) error {
+	return goen.AggregateScanner(&v.v).Scan(src)
+}
+
+// _Blog_CountPosts_AggregateLoader aggregates Posts by count for each Blog in one grouped query.
+type _Blog_CountPosts_AggregateLoader struct {
+	dbset *BlogDBSet
+}
+
+func (dbset *BlogDBSet) CountPosts() _Blog_CountPosts_AggregateLoader {
+	return _Blog_CountPosts_AggregateLoader{dbset}
+}
+
+// Query gets aggregated values keyed by BlogID of given entities.
+func (l _Blog_CountPosts_AggregateLoader) Query(entities []*Blog) (map[github_com_satori_go_uuid.UUID]int64, error) {
+	return l.QueryContext(context.Background(), entities)
+}
+
+func (l _Blog_CountPosts_AggregateLoader) QueryContext(ctx context.Context, entities []*Blog) (map[github_com_satori_go_uuid.UUID]int64, error) {
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[github_com_satori_go_uuid.UUID]int64, len(entities))
+	for _, entity := range entities {
+		out[entity.BlogID] = values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+	}
+	return out, nil
+}
+
+func (l _Blog_CountPosts_AggregateLoader) rowKeyOf(v *Blog) goen.RowKey {
+	return &goen.MapRowKey{
+		Table: "posts",
+		Key: map[string]interface{}{
+			"blog_id": v.BlogID,
+		},
+	}
+}
+
+// query gets aggregated values keyed by key string of rowKeyOf.
+func (l _Blog_CountPosts_AggregateLoader) query(ctx context.Context, entities []*Blog) (map[string]int64, error) {
+	values := map[string]int64{}
+	if len(entities) == 0 {
+		return values, nil
+	}
+
+	rowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		rowKeys[i] = l.rowKeyOf(entity)
+	}
+	dialect := l.dbset.dbc.Dialect()
+	keyColumns := []string{
+		dialect.Quote("blog_id"),
+	}
+	expr := "count(*)"
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dialect.PlaceholderFormat())
+	var aggrRows []_Blog_CountPosts_AggregateRow
+	for _, cond := range l.dbset.dbc.RowKeyConditions(rowKeys) {
+		query, args, err := stmtBuilder.Select(keyColumns...).
+			Column(expr + " AS " + dialect.Quote("goen_aggregate")).
+			From(dialect.Quote("posts")).
+			Where(cond).
+			GroupBy(keyColumns...).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to aggrRows for each chunk
+		if err := l.dbset.dbc.Scan(rows, &aggrRows); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	for _, row := range aggrRows {
+		rowKey := &goen.MapRowKey{
+			Table: "posts",
+			Key: map[string]interface{}{
+				"blog_id": row.Key0,
+			},
+		}
+		values[metaSchema.KeyStringFromRowKey(rowKey)] = row.Value.v
+	}
+	return values, nil
+}
+
+type _Blog_AvgPostsOrder_AggregateRow struct {
+	Key0  github_com_satori_go_uuid.UUID     `column:"blog_id"`
+	Value _Blog_AvgPostsOrder_AggregateValue `column:"goen_aggregate"`
+}
+
+// _Blog_AvgPostsOrder_AggregateValue scans an aggregated value by goen.AggregateScanner, it's left zero for NULL.
+type _Blog_AvgPostsOrder_AggregateValue struct {
+	v int
+}
+
+func (v *_Blog_AvgPostsOrder_AggregateValue) Scan(src interface{}) error {
+	return goen.AggregateScanner(&v.v).Scan(src)
+}
+
+// _Blog_AvgPostsOrder_AggregateLoader aggregates Posts by avg for each Blog in one grouped query.
+type _Blog_AvgPostsOrder_AggregateLoader struct {
+	dbset *BlogDBSet
+}
+
+func (dbset *BlogDBSet) AvgPostsOrder() _Blog_AvgPostsOrder_AggregateLoader {
+	return _Blog_AvgPostsOrder_AggregateLoader{dbset}
+}
+
+func (l _Blog_AvgPostsOrder_AggregateLoader) Load(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+	assign, err := l.Fetch(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
+}
+
+func (l _Blog_AvgPostsOrder_AggregateLoader) Fetch(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Blog)
+	if !ok || len(entities) == 0 {
+		return func() {}, nil
+	}
+
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		for _, entity := range entities {
+			value := values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+			entity.AvgOrder = (int)(value)
+		}
+	}, nil
+}
+
+// Query gets aggregated values keyed by BlogID of given entities.
+func (l _Blog_AvgPostsOrder_AggregateLoader) Query(entities []*Blog) (map[github_com_satori_go_uuid.UUID]int, error) {
+	return l.QueryContext(context.Background(), entities)
+}
+
+func (l _Blog_AvgPostsOrder_AggregateLoader) QueryContext(ctx context.Context, entities []*Blog) (map[github_com_satori_go_uuid.UUID]int, error) {
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[github_com_satori_go_uuid.UUID]int, len(entities))
+	for _, entity := range entities {
+		out[entity.BlogID] = values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+	}
+	return out, nil
+}
+
+func (l _Blog_AvgPostsOrder_AggregateLoader) rowKeyOf(v *Blog) goen.RowKey {
+	return &goen.MapRowKey{
+		Table: "posts",
+		Key: map[string]interface{}{
+			"blog_id": v.BlogID,
+		},
+	}
+}
+
+// query gets aggregated values keyed by key string of rowKeyOf.
+func (l _Blog_AvgPostsOrder_AggregateLoader) query(ctx context.Context, entities []*Blog) (map[string]int, error) {
+	values := map[string]int{}
+	if len(entities) == 0 {
+		return values, nil
+	}
+
+	rowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		rowKeys[i] = l.rowKeyOf(entity)
+	}
+	dialect := l.dbset.dbc.Dialect()
+	keyColumns := []string{
+		dialect.Quote("blog_id"),
+	}
+	expr := "avg(" + dialect.Quote("order") + ")"
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dialect.PlaceholderFormat())
+	var aggrRows []_Blog_AvgPostsOrder_AggregateRow
+	for _, cond := range l.dbset.dbc.RowKeyConditions(rowKeys) {
+		query, args, err := stmtBuilder.Select(keyColumns...).
+			Column(expr + " AS " + dialect.Quote("goen_aggregate")).
+			From(dialect.Quote("posts")).
+			Where(cond).
+			GroupBy(keyColumns...).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to aggrRows for each chunk
+		if err := l.dbset.dbc.Scan(rows, &aggrRows); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	for _, row := range aggrRows {
+		rowKey := &goen.MapRowKey{
+			Table: "posts",
+			Key: map[string]interface{}{
+				"blog_id": row.Key0,
+			},
+		}
+		values[metaSchema.KeyStringFromRowKey(rowKey)] = row.Value.v
+	}
+	return values, nil
+}
+
+type _Blog_MaxPostsDeletedAt_AggregateRow struct {
+	Key0  github_com_satori_go_uuid.UUID         `column:"blog_id"`
+	Value _Blog_MaxPostsDeletedAt_AggregateValue `column:"goen_aggregate"`
+}
+
+// _Blog_MaxPostsDeletedAt_AggregateValue scans an aggregated value by goen.AggregateScanner, it's left zero for NULL.
+type _Blog_MaxPostsDeletedAt_AggregateValue struct {
+	v *time.Time
+}
+
+func (v *_Blog_MaxPostsDeletedAt_AggregateValue) Scan(src interface{}) error {
+	return goen.AggregateScanner(&v.v).Scan(src)
+}
+
+// _Blog_MaxPostsDeletedAt_AggregateLoader aggregates Posts by max for each Blog in one grouped query.
+type _Blog_MaxPostsDeletedAt_AggregateLoader struct {
+	dbset *BlogDBSet
+}
+
+func (dbset *BlogDBSet) MaxPostsDeletedAt() _Blog_MaxPostsDeletedAt_AggregateLoader {
+	return _Blog_MaxPostsDeletedAt_AggregateLoader{dbset}
+}
+
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) Load(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+	assign, err := l.Fetch(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
+}
+
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) Fetch(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Blog)
+	if !ok || len(entities) == 0 {
+		return func() {}, nil
+	}
+
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		for _, entity := range entities {
+			value := values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+			entity.LastDeletedAt = (*time.Time)(value)
+		}
+	}, nil
+}
+
+// Query gets aggregated values keyed by BlogID of given entities.
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) Query(entities []*Blog) (map[github_com_satori_go_uuid.UUID]*time.Time, error) {
+	return l.QueryContext(context.Background(), entities)
+}
+
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) QueryContext(ctx context.Context, entities []*Blog) (map[github_com_satori_go_uuid.UUID]*time.Time, error) {
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[github_com_satori_go_uuid.UUID]*time.Time, len(entities))
+	for _, entity := range entities {
+		out[entity.BlogID] = values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+	}
+	return out, nil
+}
+
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) rowKeyOf(v *Blog) goen.RowKey {
+	return &goen.MapRowKey{
+		Table: "posts",
+		Key: map[string]interface{}{
+			"blog_id": v.BlogID,
+		},
+	}
+}
+
+// query gets aggregated values keyed by key string of rowKeyOf.
+func (l _Blog_MaxPostsDeletedAt_AggregateLoader) query(ctx context.Context, entities []*Blog) (map[string]*time.Time, error) {
+	values := map[string]*time.Time{}
+	if len(entities) == 0 {
+		return values, nil
+	}
+
+	rowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		rowKeys[i] = l.rowKeyOf(entity)
+	}
+	dialect := l.dbset.dbc.Dialect()
+	keyColumns := []string{
+		dialect.Quote("blog_id"),
+	}
+	expr := "max(" + dialect.Quote("deleted_at") + ")"
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dialect.PlaceholderFormat())
+	var aggrRows []_Blog_MaxPostsDeletedAt_AggregateRow
+	for _, cond := range l.dbset.dbc.RowKeyConditions(rowKeys) {
+		query, args, err := stmtBuilder.Select(keyColumns...).
+			Column(expr + " AS " + dialect.Quote("goen_aggregate")).
+			From(dialect.Quote("posts")).
+			Where(cond).
+			GroupBy(keyColumns...).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to aggrRows for each chunk
+		if err := l.dbset.dbc.Scan(rows, &aggrRows); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	for _, row := range aggrRows {
+		rowKey := &goen.MapRowKey{
+			Table: "posts",
+			Key: map[string]interface{}{
+				"blog_id": row.Key0,
+			},
+		}
+		values[metaSchema.KeyStringFromRowKey(rowKey)] = row.Value.v
+	}
+	return values, nil
+}
+
+func init() {
+	metaSchema.Register(Post{})
+}
+
+type PostSqlizer interface {
+	goen.EntitySqlizer
+
+	PostToSql() (string, []interface{}, error)
+}
+
+type _PostSqlizer struct {
+	squirrel.Sqlizer
+}
+
+func (sqlizer *_PostSqlizer) PostToSql() (string, []interface{}, error) {
+	return sqlizer.ToSql()
+}
+
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_PostSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
+type PostColumnExpr interface {
+	PostColumnExpr() string
+
+	String() string
+}
+
+type PostOrderExpr interface {
+	PostOrderExpr() string
+}
+
+type PostAssignment interface {
+	// PostAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	PostAssignment() (column string, value interface{})
+}
+
+type _PostAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _PostAssignment) PostAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type PostAggregateExpr interface {
+	// PostAggregateExpr gets an aggregate function call and its result column name.
+	PostAggregateExpr() (expr string, name string)
+}
+
+type _PostAggregateExpr struct {
+	expr string
+	name string
+}
+
+// PostAggregateExpr implements PostAggregateExpr.
+func (e _PostAggregateExpr) PostAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _PostAggregateExpr) As(name string) _PostAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _PostAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _PostAggregateExpr) Eq(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) NotEq(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) Lt(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) LtOrEq(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) Gt(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) GtOrEq(v interface{}) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _PostAggregateExpr) Asc() PostOrderExpr {
+	return _PostAggregateOrderExpr(e.expr)
+}
+
+func (e _PostAggregateExpr) Desc() PostOrderExpr {
+	return _PostAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _PostAggregateOrderExpr string
+
+func (s _PostAggregateOrderExpr) PostOrderExpr() string {
+	return string(s)
+}
+
+// PostPage is a page of keyset pagination.
+type PostPage struct {
+	Records []*Post
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
+type PostQueryBuilder struct {
+	dbc *goen.DBContext
+
+	includeLoaders goen.IncludeLoaderList
+
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
+	builder squirrel.SelectBuilder
+
+	Blog _Post_Join_Blog
+}
+
+func newPostQueryBuilder(dbc *goen.DBContext) PostQueryBuilder {
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
+	metaT := metaSchema.LoadOf(&Post{})
+	return PostQueryBuilder{
 		dbc: dbc,
 		// columns provided later
 		builder: stmtBuilder.Select().From(dbc.Dialect().Quote(metaT.TableName())),
 	}
 }
 
-func (qb PostQueryBuilder) Include(loaders ...goen.IncludeLoader) PostQueryBuilder {
-	qb.includeLoaders.Append(loaders...)
-	return qb
+func (qb PostQueryBuilder) Include(loaders ...goen.IncludeLoader) PostQueryBuilder {
+	qb.includeLoaders.Append(loaders...)
+	return qb
+}
+
+func (qb PostQueryBuilder) Where(conds ...PostSqlizer) PostQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb PostQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) PostQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb PostQueryBuilder) Offset(offset uint64) PostQueryBuilder {
+	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
+	return qb
+}
+
+func (qb PostQueryBuilder) Limit(limit uint64) PostQueryBuilder {
+	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
+	return qb
+}
+
+func (qb PostQueryBuilder) OrderBy(orderBys ...PostOrderExpr) PostQueryBuilder {
+	exprs := make([]string, len(orderBys))
+	for i := range orderBys {
+		exprs[i] = orderBys[i].PostOrderExpr()
+	}
+	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb PostQueryBuilder) Columns(cols ...PostColumnExpr) PostQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb PostQueryBuilder) ForUpdate() PostQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
+
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb PostQueryBuilder) ForShare() PostQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb PostQueryBuilder) SkipLocked() PostQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
+}
+
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb PostQueryBuilder) NoWait() PostQueryBuilder {
+	qb.lock = qb.lock.NoWait()
+	return qb
+}
+
+// JoinBlog joins blogs as blog by the Blog relation, then qb.Blog refers its columns.
+// It's a left join, and the result set is still scanned into Post only.
+func (qb PostQueryBuilder) JoinBlog() PostQueryBuilder {
+	if qb.Blog.joined {
+		return qb
+	}
+	dialect := qb.dbc.Dialect()
+	table := dialect.Quote(metaSchema.LoadOf(&Post{}).TableName())
+	alias := dialect.Quote("blog")
+	var on string
+	for i, pair := range [][2]string{
+		{"blog_id", "blog_id"},
+	} {
+		if i > 0 {
+			on += " AND "
+		}
+		on += alias + "." + dialect.Quote(pair[0]) + " = " + table + "." + dialect.Quote(pair[1])
+	}
+	qb.builder = qb.builder.LeftJoin(dialect.Quote("blogs") + " AS " + alias + " ON " + on)
+	qb.Blog = new_Post_Join_Blog(qb.dbc, alias)
+	qb.joined = true
+	return qb
+}
+
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb PostQueryBuilder) GroupBy(cols ...PostColumnExpr) PostQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
+	return qb
+}
+
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb PostQueryBuilder) Having(conds ...PostSqlizer) PostQueryBuilder {
+	for _, cond := range conds {
+		qb.builder = qb.builder.Having(cond)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
+	return qb
+}
+
+func (qb PostQueryBuilder) ScanGroups(v interface{}, aggrs ...PostAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
+}
+
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb PostQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...PostAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.PostAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
+}
+
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb PostQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb PostQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...PostOrderExpr) (*PostPage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Post, and qb must not be ordered or limited by itself.
+func (qb PostQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...PostOrderExpr) (*PostPage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
+	for i := range orderBys {
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Post{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &PostPage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb PostQueryBuilder) UpdateAll(sets ...PostAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Post{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].PostAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb PostQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Post{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb PostQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb PostQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
+func (qb PostQueryBuilder) Count() (int64, error) {
+	return qb.CountContext(context.Background())
+}
+
+func (qb PostQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
+	query, args, err := qb.builder.Columns("count(*)").ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (qb PostQueryBuilder) Query() ([]*Post, error) {
+	return qb.QueryContext(context.Background())
+}
+
+func (qb PostQueryBuilder) QueryContext(ctx context.Context) ([]*Post, error) {
+	return qb.query(ctx)
+}
+
+func (qb PostQueryBuilder) QueryRow() (*Post, error) {
+	return qb.QueryRowContext(context.Background())
+}
+
+func (qb PostQueryBuilder) QueryRowContext(ctx context.Context) (*Post, error) {
+	qb.builder = qb.builder.Limit(1)
+	if records, err := qb.query(ctx); err != nil {
+		return nil, err
+	} else if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	} else {
+		return records[0], nil
+	}
+}
+
+func (qb PostQueryBuilder) query(ctx context.Context) ([]*Post, error) {
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
+	}
+
+	var lockTables []string
+	if qb.joined {
+		// only rows of posts are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("posts"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*Post
+	if err := qb.dbc.Scan(rows, &records); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
+	sc := goen.NewScopeCache(metaSchema)
+	for _, record := range records {
+		sc.AddObject(record)
+	}
+	if err := qb.dbc.IncludeContext(ctx, records, sc, qb.includeLoaders); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// columnNames gets column names to be selected in table order.
+func (qb PostQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Post{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+		selected["blog_id"] = true
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb PostQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Post{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
+// ToSqlizer returns Sqlizer that built by PostQueryBuilder with given columns.
+// The columns defaults to all columns of Post, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
+func (qb PostQueryBuilder) ToSqlizer(columns ...string) PostSqlizer {
+	if len(columns) == 0 {
+		metaT := metaSchema.LoadOf(&Post{})
+		columns = make([]string, len(metaT.Columns()))
+		for i := range metaT.Columns() {
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
+		}
+	}
+	// only return, not to set qb.builder.
+	return &_PostSqlizer{qb.builder.Columns(columns...)}
+}
+
+type _Post_CreatedAt_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_CreatedAt_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_CreatedAt_OrderExpr) CursorOrder() (string, bool) {
+	return "created_at", s.desc
+}
+
+type _Post_CreatedAt struct {
+	bs string
+	qs string
+	es string
+}
+
+// PostColumnExpr implements _Post_CreatedAt_OrderExpr.
+func (c _Post_CreatedAt) PostColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Post_CreatedAt) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Post_CreatedAt) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_CreatedAt) expr() string {
+	return c.es
+}
+
+func (c _Post_CreatedAt) Eq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) NotEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) In(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_CreatedAt) NotIn(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_CreatedAt) Like(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_CreatedAt) NotLike(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_CreatedAt) Lt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) LtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) Gt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) GtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_CreatedAt) Between(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Post_CreatedAt) NotBetween(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_CreatedAt) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_CreatedAt) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_CreatedAt) Set(v time.Time) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Post_CreatedAt) Asc() PostOrderExpr {
+	return _Post_CreatedAt_OrderExpr{c.expr(), false}
+}
+
+func (c _Post_CreatedAt) Desc() PostOrderExpr {
+	return _Post_CreatedAt_OrderExpr{c.expr(), true}
+}
+
+func (c _Post_CreatedAt) Min(qb PostQueryBuilder) (time.Time, error) {
+	return c.MinContext(context.Background(), qb)
+}
+
+// MinContext gets the minimum of the column over rows of qb, it's zero for no rows.
+func (c _Post_CreatedAt) MinContext(ctx context.Context, qb PostQueryBuilder) (time.Time, error) {
+	var v time.Time
+	err := qb.aggregate(ctx, c.MinExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_CreatedAt) Max(qb PostQueryBuilder) (time.Time, error) {
+	return c.MaxContext(context.Background(), qb)
+}
+
+// MaxContext gets the maximum of the column over rows of qb, it's zero for no rows.
+func (c _Post_CreatedAt) MaxContext(ctx context.Context, qb PostQueryBuilder) (time.Time, error) {
+	var v time.Time
+	err := qb.aggregate(ctx, c.MaxExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_CreatedAt) MinExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MIN(" + c.expr() + ")", "min_" + c.String()}
+}
+
+func (c _Post_CreatedAt) MaxExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MAX(" + c.expr() + ")", "max_" + c.String()}
+}
+
+type _Post_UpdatedAt_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_UpdatedAt_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_UpdatedAt_OrderExpr) CursorOrder() (string, bool) {
+	return "updated_at", s.desc
+}
+
+type _Post_UpdatedAt struct {
+	bs string
+	qs string
+	es string
+}
+
+// PostColumnExpr implements _Post_UpdatedAt_OrderExpr.
+func (c _Post_UpdatedAt) PostColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Post_UpdatedAt) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Post_UpdatedAt) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_UpdatedAt) expr() string {
+	return c.es
+}
+
+func (c _Post_UpdatedAt) Eq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) NotEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) In(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_UpdatedAt) NotIn(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_UpdatedAt) Like(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_UpdatedAt) NotLike(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_UpdatedAt) Lt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) LtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) Gt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) GtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_UpdatedAt) Between(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Post_UpdatedAt) NotBetween(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_UpdatedAt) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_UpdatedAt) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_UpdatedAt) Set(v time.Time) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Post_UpdatedAt) Asc() PostOrderExpr {
+	return _Post_UpdatedAt_OrderExpr{c.expr(), false}
+}
+
+func (c _Post_UpdatedAt) Desc() PostOrderExpr {
+	return _Post_UpdatedAt_OrderExpr{c.expr(), true}
+}
+
+func (c _Post_UpdatedAt) Min(qb PostQueryBuilder) (time.Time, error) {
+	return c.MinContext(context.Background(), qb)
+}
+
+// MinContext gets the minimum of the column over rows of qb, it's zero for no rows.
+func (c _Post_UpdatedAt) MinContext(ctx context.Context, qb PostQueryBuilder) (time.Time, error) {
+	var v time.Time
+	err := qb.aggregate(ctx, c.MinExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_UpdatedAt) Max(qb PostQueryBuilder) (time.Time, error) {
+	return c.MaxContext(context.Background(), qb)
+}
+
+// MaxContext gets the maximum of the column over rows of qb, it's zero for no rows.
+func (c _Post_UpdatedAt) MaxContext(ctx context.Context, qb PostQueryBuilder) (time.Time, error) {
+	var v time.Time
+	err := qb.aggregate(ctx, c.MaxExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_UpdatedAt) MinExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MIN(" + c.expr() + ")", "min_" + c.String()}
+}
+
+func (c _Post_UpdatedAt) MaxExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MAX(" + c.expr() + ")", "max_" + c.String()}
+}
+
+type _Post_DeletedAt_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_DeletedAt_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_DeletedAt_OrderExpr) CursorOrder() (string, bool) {
+	return "deleted_at", s.desc
+}
+
+type _Post_DeletedAt struct {
+	bs string
+	qs string
+	es string
+}
+
+// PostColumnExpr implements _Post_DeletedAt_OrderExpr.
+func (c _Post_DeletedAt) PostColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Post_DeletedAt) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Post_DeletedAt) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_DeletedAt) expr() string {
+	return c.es
+}
+
+// Eq makes a condition whether the column equals to v, it's IS NULL for nil or NULL.
+func (c _Post_DeletedAt) Eq(v *time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertNullableValue(v)}}
+}
+
+// NotEq makes a condition whether the column not equals to v, it's IS NOT NULL for nil or NULL.
+func (c _Post_DeletedAt) NotEq(v *time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertNullableValue(v)}}
+}
+
+// IsNull makes a condition whether the column is NULL.
+func (c _Post_DeletedAt) IsNull() PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): nil}}
+}
+
+// IsNotNull makes a condition whether the column is not NULL.
+func (c _Post_DeletedAt) IsNotNull() PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): nil}}
+}
+
+func (c _Post_DeletedAt) In(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_DeletedAt) NotIn(v ...time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Post_DeletedAt) Like(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_DeletedAt) NotLike(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_DeletedAt) Lt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_DeletedAt) LtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_DeletedAt) Gt(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_DeletedAt) GtOrEq(v time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_DeletedAt) Between(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Post_DeletedAt) NotBetween(v1, v2 time.Time) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_DeletedAt) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_DeletedAt) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_DeletedAt) Set(v *time.Time) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Post_DeletedAt) Asc() PostOrderExpr {
+	return _Post_DeletedAt_OrderExpr{c.expr(), false}
+}
+
+func (c _Post_DeletedAt) Desc() PostOrderExpr {
+	return _Post_DeletedAt_OrderExpr{c.expr(), true}
+}
+
+func (c _Post_DeletedAt) Min(qb PostQueryBuilder) (*time.Time, error) {
+	return c.MinContext(context.Background(), qb)
+}
+
+// MinContext gets the minimum of the column over rows of qb, it's zero for no rows.
+func (c _Post_DeletedAt) MinContext(ctx context.Context, qb PostQueryBuilder) (*time.Time, error) {
+	var v *time.Time
+	err := qb.aggregate(ctx, c.MinExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_DeletedAt) Max(qb PostQueryBuilder) (*time.Time, error) {
+	return c.MaxContext(context.Background(), qb)
+}
+
+// MaxContext gets the maximum of the column over rows of qb, it's zero for no rows.
+func (c _Post_DeletedAt) MaxContext(ctx context.Context, qb PostQueryBuilder) (*time.Time, error) {
+	var v *time.Time
+	err := qb.aggregate(ctx, c.MaxExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_DeletedAt) MinExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MIN(" + c.expr() + ")", "min_" + c.String()}
+}
+
+func (c _Post_DeletedAt) MaxExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MAX(" + c.expr() + ")", "max_" + c.String()}
+}
+
+type _Post_BlogID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_BlogID_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_BlogID_OrderExpr) CursorOrder() (string, bool) {
+	return "blog_id", s.desc
 }
 
-func (qb PostQueryBuilder) Where(conds ...PostSqlizer) PostQueryBuilder {
-	for _, cond := range conds {
-		qb.builder = qb.builder.Where(cond)
-	}
-	return qb
+type _Post_BlogID struct {
+	bs string
+	qs string
+	es string
 }
 
-func (qb PostQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) PostQueryBuilder {
-	for _, cond := range conds {
-		qb.builder = qb.builder.Where(cond)
-	}
-	return qb
+// PostColumnExpr implements _Post_BlogID_OrderExpr.
+func (c _Post_BlogID) PostColumnExpr() string {
+	return c.QuotedString()
 }
 
-func (qb PostQueryBuilder) Offset(offset uint64) PostQueryBuilder {
-	qb.builder = qb.builder.Offset(offset)
-	return qb
+// String gets bare column name.
+func (c _Post_BlogID) String() string {
+	return c.bs
 }
 
-func (qb PostQueryBuilder) Limit(limit uint64) PostQueryBuilder {
-	qb.builder = qb.builder.Limit(limit)
-	return qb
+// QuotedString gets quoted column name.
+func (c _Post_BlogID) QuotedString() string {
+	return c.qs
 }
 
-func (qb PostQueryBuilder) OrderBy(orderBys ...PostOrderExpr) PostQueryBuilder {
-	exprs := make([]string, len(orderBys))
-	for i := range orderBys {
-		exprs[i] = orderBys[i].PostOrderExpr()
-	}
-	qb.builder = qb.builder.OrderBy(exprs...)
-	return qb
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_BlogID) expr() string {
+	return c.es
 }
 
-func (qb PostQueryBuilder) Count() (int64, error) {
-	return qb.CountContext(context.Background())
+func (c _Post_BlogID) Eq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (qb PostQueryBuilder) CountContext(ctx context.Context) (int64, error) {
-	query, args, err := qb.builder.Columns("count(*)").ToSql()
-	if err != nil {
-		return 0, err
-	}
+func (c _Post_BlogID) NotEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
 
-	var count int64
-	row := qb.dbc.QueryRowContext(ctx, query, args...)
-	if err := row.Scan(&count); err != nil {
-		return 0, err
-	}
-	return count, nil
+func (c _Post_BlogID) In(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (qb PostQueryBuilder) Query() ([]*Post, error) {
-	return qb.QueryContext(context.Background())
+func (c _Post_BlogID) NotIn(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (qb PostQueryBuilder) QueryContext(ctx context.Context) ([]*Post, error) {
-	return qb.query(ctx)
+func (c _Post_BlogID) Like(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (qb PostQueryBuilder) QueryRow() (*Post, error) {
-	return qb.QueryRowContext(context.Background())
+func (c _Post_BlogID) NotLike(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (qb PostQueryBuilder) QueryRowContext(ctx context.Context) (*Post, error) {
-	qb.builder = qb.builder.Limit(1)
-	if records, err := qb.query(ctx); err != nil {
-		return nil, err
-	} else if len(records) == 0 {
-		return nil, sql.ErrNoRows
-	} else {
-		return records[0], nil
-	}
+func (c _Post_BlogID) Lt(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (qb PostQueryBuilder) query(ctx context.Context) ([]*Post, error) {
-	// for caching reason, wont support filtering columns
-	metaT := metaSchema.LoadOf(&Post{})
-	cols := make([]string, len(metaT.Columns()))
-	for i := range metaT.Columns() {
-		cols[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
-	}
+func (c _Post_BlogID) LtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
 
-	query, args, err := qb.builder.Columns(cols...).ToSql()
-	if err != nil {
-		return nil, err
-	}
-	rows, err := qb.dbc.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, err
-	}
+func (c _Post_BlogID) Gt(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
 
-	var records []*Post
-	if err := qb.dbc.Scan(rows, &records); err != nil {
-		rows.Close()
-		return nil, err
-	}
-	rows.Close()
+func (c _Post_BlogID) GtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
 
-	sc := goen.NewScopeCache(metaSchema)
-	for _, record := range records {
-		sc.AddObject(record)
-	}
-	if err := qb.dbc.IncludeContext(ctx, records, sc, qb.includeLoaders); err != nil {
-		return nil, err
-	}
+func (c _Post_BlogID) Between(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
 
-	return records, nil
+func (c _Post_BlogID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-// ToSqlizer returns Sqlizer that built by PostQueryBuilder with given columns.
-// The columns defaults to all columns of Post, if columns is zero-length.
-func (qb PostQueryBuilder) ToSqlizer(columns ...string) PostSqlizer {
-	if len(columns) == 0 {
-		metaT := metaSchema.LoadOf(&Post{})
-		columns = make([]string, len(metaT.Columns()))
-		for i := range metaT.Columns() {
-			columns[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
-		}
-	}
-	// only return, not to set qb.builder.
-	return &_PostSqlizer{qb.builder.Columns(columns...)}
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_BlogID) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-type _Post_CreatedAt_OrderExpr string
+func (c _Post_BlogID) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
 
-func (s _Post_CreatedAt_OrderExpr) PostOrderExpr() string {
-	return string(s)
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_BlogID) Set(v github_com_satori_go_uuid.UUID) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
 }
 
-type _Post_CreatedAt struct {
+func (c _Post_BlogID) Asc() PostOrderExpr {
+	return _Post_BlogID_OrderExpr{c.expr(), false}
+}
+
+func (c _Post_BlogID) Desc() PostOrderExpr {
+	return _Post_BlogID_OrderExpr{c.expr(), true}
+}
+
+type _Post_PostID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_PostID_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_PostID_OrderExpr) CursorOrder() (string, bool) {
+	return "post_id", s.desc
+}
+
+type _Post_PostID struct {
 	bs string
 	qs string
+	es string
 }
 
-// PostColumnExpr implements _Post_CreatedAt_OrderExpr.
-func (c _Post_CreatedAt) PostColumnExpr() string {
+// PostColumnExpr implements _Post_PostID_OrderExpr.
+func (c _Post_PostID) PostColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Post_CreatedAt) String() string {
+func (c _Post_PostID) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Post_CreatedAt) QuotedString() string {
+func (c _Post_PostID) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_CreatedAt) Eq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_PostID) expr() string {
+	return c.es
 }
 
-func (c _Post_CreatedAt) NotEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_PostID) Eq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) In(v ...time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_PostID) NotEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) NotIn(v ...time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_PostID) In(v ...int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_CreatedAt) Like(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_PostID) NotIn(v ...int) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_CreatedAt) NotLike(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_PostID) Like(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_CreatedAt) Lt(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_PostID) NotLike(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_CreatedAt) LtOrEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_PostID) Lt(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) Gt(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_PostID) LtOrEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) GtOrEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_PostID) Gt(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) Between(v1, v2 time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_PostID) GtOrEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_CreatedAt) NotBetween(v1, v2 time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_PostID) Between(v1, v2 int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_CreatedAt) Asc() PostOrderExpr {
-	return _Post_CreatedAt_OrderExpr(c.QuotedString())
+func (c _Post_PostID) NotBetween(v1, v2 int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_CreatedAt) Desc() PostOrderExpr {
-	return _Post_CreatedAt_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_PostID) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_PostID) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
 }
 
-type _Post_UpdatedAt_OrderExpr string
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_PostID) Set(v int) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
+}
 
-func (s _Post_UpdatedAt_OrderExpr) PostOrderExpr() string {
-	return string(s)
+// Add makes an assignment adding v to the column, for UpdateAll.
+func (c _Post_PostID) Add(v int) PostAssignment {
+	return _PostAssignment{c.String(), squirrel.Expr(c.expr()+" + ?", goen.ConvertValue(v))}
 }
 
-type _Post_UpdatedAt struct {
+func (c _Post_PostID) Asc() PostOrderExpr {
+	return _Post_PostID_OrderExpr{c.expr(), false}
+}
+
+func (c _Post_PostID) Desc() PostOrderExpr {
+	return _Post_PostID_OrderExpr{c.expr(), true}
+}
+
+func (c _Post_PostID) Sum(qb PostQueryBuilder) (int64, error) {
+	return c.SumContext(context.Background(), qb)
+}
+
+// SumContext sums the column over rows of qb, it's zero for no rows.
+func (c _Post_PostID) SumContext(ctx context.Context, qb PostQueryBuilder) (int64, error) {
+	var v int64
+	err := qb.aggregate(ctx, c.SumExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_PostID) Avg(qb PostQueryBuilder) (float64, error) {
+	return c.AvgContext(context.Background(), qb)
+}
+
+// AvgContext averages the column over rows of qb, it's zero for no rows.
+func (c _Post_PostID) AvgContext(ctx context.Context, qb PostQueryBuilder) (float64, error) {
+	var v float64
+	err := qb.aggregate(ctx, c.AvgExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_PostID) SumExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"SUM(" + c.expr() + ")", "sum_" + c.String()}
+}
+
+func (c _Post_PostID) AvgExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"AVG(" + c.expr() + ")", "avg_" + c.String()}
+}
+
+func (c _Post_PostID) Min(qb PostQueryBuilder) (int, error) {
+	return c.MinContext(context.Background(), qb)
+}
+
+// MinContext gets the minimum of the column over rows of qb, it's zero for no rows.
+func (c _Post_PostID) MinContext(ctx context.Context, qb PostQueryBuilder) (int, error) {
+	var v int
+	err := qb.aggregate(ctx, c.MinExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_PostID) Max(qb PostQueryBuilder) (int, error) {
+	return c.MaxContext(context.Background(), qb)
+}
+
+// MaxContext gets the maximum of the column over rows of qb, it's zero for no rows.
+func (c _Post_PostID) MaxContext(ctx context.Context, qb PostQueryBuilder) (int, error) {
+	var v int
+	err := qb.aggregate(ctx, c.MaxExpr().expr, &v)
+	return v, err
+}
+
+func (c _Post_PostID) MinExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MIN(" + c.expr() + ")", "min_" + c.String()}
+}
+
+func (c _Post_PostID) MaxExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MAX(" + c.expr() + ")", "max_" + c.String()}
+}
+
+type _Post_Title_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_Title_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_Title_OrderExpr) CursorOrder() (string, bool) {
+	return "title", s.desc
+}
+
+type _Post_Title struct {
 	bs string
 	qs string
+	es string
 }
 
-// PostColumnExpr implements _Post_UpdatedAt_OrderExpr.
-func (c _Post_UpdatedAt) PostColumnExpr() string {
+// PostColumnExpr implements _Post_Title_OrderExpr.
+func (c _Post_Title) PostColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Post_UpdatedAt) String() string {
+func (c _Post_Title) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Post_UpdatedAt) QuotedString() string {
+func (c _Post_Title) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_UpdatedAt) Eq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_Title) expr() string {
+	return c.es
 }
 
-func (c _Post_UpdatedAt) NotEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Title) Eq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_UpdatedAt) In(v ...time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Title) NotEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_UpdatedAt) NotIn(v ...time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Title) In(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_UpdatedAt) Like(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Title) NotIn(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_UpdatedAt) NotLike(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Title) Like(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_UpdatedAt) Lt(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Title) NotLike(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Post_Title) Lt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Title) LtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Title) Gt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Title) GtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Title) Between(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_UpdatedAt) LtOrEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Title) NotBetween(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_UpdatedAt) Gt(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Title) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-func (c _Post_UpdatedAt) GtOrEq(v time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Title) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
 }
 
-func (c _Post_UpdatedAt) Between(v1, v2 time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_Title) Set(v string) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
 }
 
-func (c _Post_UpdatedAt) NotBetween(v1, v2 time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Title) Asc() PostOrderExpr {
+	return _Post_Title_OrderExpr{c.expr(), false}
 }
 
-func (c _Post_UpdatedAt) Asc() PostOrderExpr {
-	return _Post_UpdatedAt_OrderExpr(c.QuotedString())
+func (c _Post_Title) Desc() PostOrderExpr {
+	return _Post_Title_OrderExpr{c.expr(), true}
 }
 
-func (c _Post_UpdatedAt) Desc() PostOrderExpr {
-	return _Post_UpdatedAt_OrderExpr(c.QuotedString() + " DESC")
+type _Post_Content_OrderExpr struct {
+	expr string
+	desc bool
 }
 
-type _Post_DeletedAt_OrderExpr string
+func (s _Post_Content_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
 
-func (s _Post_DeletedAt_OrderExpr) PostOrderExpr() string {
-	return string(s)
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_Content_OrderExpr) CursorOrder() (string, bool) {
+	return "content", s.desc
 }
 
-type _Post_DeletedAt struct {
+type _Post_Content struct {
 	bs string
 	qs string
+	es string
 }
 
-// PostColumnExpr implements _Post_DeletedAt_OrderExpr.
-func (c _Post_DeletedAt) PostColumnExpr() string {
+// PostColumnExpr implements _Post_Content_OrderExpr.
+func (c _Post_Content) PostColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Post_DeletedAt) String() string {
+func (c _Post_Content) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Post_DeletedAt) QuotedString() string {
+func (c _Post_Content) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_DeletedAt) Eq(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_Content) expr() string {
+	return c.es
 }
 
-func (c _Post_DeletedAt) NotEq(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Content) Eq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Content) NotEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Post_Content) In(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_DeletedAt) In(v ...*time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Content) NotIn(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_DeletedAt) NotIn(v ...*time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Content) Like(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_DeletedAt) Like(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Content) NotLike(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_DeletedAt) NotLike(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Content) Lt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_DeletedAt) Lt(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Content) LtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_DeletedAt) LtOrEq(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Content) Gt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_DeletedAt) Gt(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Content) GtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_DeletedAt) GtOrEq(v *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Content) Between(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_DeletedAt) Between(v1, v2 *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Content) NotBetween(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_DeletedAt) NotBetween(v1, v2 *time.Time) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Content) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-func (c _Post_DeletedAt) Asc() PostOrderExpr {
-	return _Post_DeletedAt_OrderExpr(c.QuotedString())
+func (c _Post_Content) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
 }
 
-func (c _Post_DeletedAt) Desc() PostOrderExpr {
-	return _Post_DeletedAt_OrderExpr(c.QuotedString() + " DESC")
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_Content) Set(v string) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
 }
 
-type _Post_BlogID_OrderExpr string
+func (c _Post_Content) Asc() PostOrderExpr {
+	return _Post_Content_OrderExpr{c.expr(), false}
+}
 
-func (s _Post_BlogID_OrderExpr) PostOrderExpr() string {
-	return string(s)
+func (c _Post_Content) Desc() PostOrderExpr {
+	return _Post_Content_OrderExpr{c.expr(), true}
 }
 
-type _Post_BlogID struct {
+type _Post_Order_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Post_Order_OrderExpr) PostOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Post_Order_OrderExpr) CursorOrder() (string, bool) {
+	return "order", s.desc
+}
+
+type _Post_Order struct {
 	bs string
 	qs string
+	es string
 }
 
-// PostColumnExpr implements _Post_BlogID_OrderExpr.
-func (c _Post_BlogID) PostColumnExpr() string {
+// PostColumnExpr implements _Post_Order_OrderExpr.
+func (c _Post_Order) PostColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Post_BlogID) String() string {
+func (c _Post_Order) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Post_BlogID) QuotedString() string {
+func (c _Post_Order) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_BlogID) Eq(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Post_Order) expr() string {
+	return c.es
 }
 
-func (c _Post_BlogID) NotEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Order) Eq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) In(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Order) NotEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) NotIn(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Order) In(v ...int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_BlogID) Like(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Order) NotIn(v ...int) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_BlogID) NotLike(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Order) Like(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_BlogID) Lt(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Order) NotLike(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_BlogID) LtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Order) Lt(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) Gt(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Order) LtOrEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) GtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Order) Gt(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) Between(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Order) GtOrEq(v int) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_BlogID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Order) Between(v1, v2 int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_BlogID) Asc() PostOrderExpr {
-	return _Post_BlogID_OrderExpr(c.QuotedString())
+func (c _Post_Order) NotBetween(v1, v2 int) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_BlogID) Desc() PostOrderExpr {
-	return _Post_BlogID_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Order) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-type _Post_PostID_OrderExpr string
-
-func (s _Post_PostID_OrderExpr) PostOrderExpr() string {
-	return string(s)
+func (c _Post_Order) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
 }
 
-type _Post_PostID struct {
-	bs string
-	qs string
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Post_Order) Set(v int) PostAssignment {
+	return _PostAssignment{c.String(), goen.ConvertValue(v)}
 }
 
-// PostColumnExpr implements _Post_PostID_OrderExpr.
-func (c _Post_PostID) PostColumnExpr() string {
-	return c.QuotedString()
+// Add makes an assignment adding v to the column, for UpdateAll.
+func (c _Post_Order) Add(v int) PostAssignment {
+	return _PostAssignment{c.String(), squirrel.Expr(c.expr()+" + ?", goen.ConvertValue(v))}
 }
 
-// String gets bare column name.
-func (c _Post_PostID) String() string {
-	return c.bs
+func (c _Post_Order) Asc() PostOrderExpr {
+	return _Post_Order_OrderExpr{c.expr(), false}
 }
 
-// QuotedString gets quoted column name.
-func (c _Post_PostID) QuotedString() string {
-	return c.qs
+func (c _Post_Order) Desc() PostOrderExpr {
+	return _Post_Order_OrderExpr{c.expr(), true}
 }
 
-func (c _Post_PostID) Eq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Order) Sum(qb PostQueryBuilder) (int64, error) {
+	return c.SumContext(context.Background(), qb)
 }
 
-func (c _Post_PostID) NotEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+// SumContext sums the column over rows of qb, it's zero for no rows.
+func (c _Post_Order) SumContext(ctx context.Context, qb PostQueryBuilder) (int64, error) {
+	var v int64
+	err := qb.aggregate(ctx, c.SumExpr().expr, &v)
+	return v, err
 }
 
-func (c _Post_PostID) In(v ...int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Order) Avg(qb PostQueryBuilder) (float64, error) {
+	return c.AvgContext(context.Background(), qb)
 }
 
-func (c _Post_PostID) NotIn(v ...int) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+// AvgContext averages the column over rows of qb, it's zero for no rows.
+func (c _Post_Order) AvgContext(ctx context.Context, qb PostQueryBuilder) (float64, error) {
+	var v float64
+	err := qb.aggregate(ctx, c.AvgExpr().expr, &v)
+	return v, err
 }
 
-func (c _Post_PostID) Like(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Order) SumExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"SUM(" + c.expr() + ")", "sum_" + c.String()}
 }
 
-func (c _Post_PostID) NotLike(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Order) AvgExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"AVG(" + c.expr() + ")", "avg_" + c.String()}
 }
 
-func (c _Post_PostID) Lt(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Order) Min(qb PostQueryBuilder) (int, error) {
+	return c.MinContext(context.Background(), qb)
 }
 
-func (c _Post_PostID) LtOrEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+// MinContext gets the minimum of the column over rows of qb, it's zero for no rows.
+func (c _Post_Order) MinContext(ctx context.Context, qb PostQueryBuilder) (int, error) {
+	var v int
+	err := qb.aggregate(ctx, c.MinExpr().expr, &v)
+	return v, err
 }
 
-func (c _Post_PostID) Gt(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Order) Max(qb PostQueryBuilder) (int, error) {
+	return c.MaxContext(context.Background(), qb)
 }
 
-func (c _Post_PostID) GtOrEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+// MaxContext gets the maximum of the column over rows of qb, it's zero for no rows.
+func (c _Post_Order) MaxContext(ctx context.Context, qb PostQueryBuilder) (int, error) {
+	var v int
+	err := qb.aggregate(ctx, c.MaxExpr().expr, &v)
+	return v, err
 }
 
-func (c _Post_PostID) Between(v1, v2 int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Order) MinExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MIN(" + c.expr() + ")", "min_" + c.String()}
 }
 
-func (c _Post_PostID) NotBetween(v1, v2 int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Order) MaxExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"MAX(" + c.expr() + ")", "max_" + c.String()}
 }
 
-func (c _Post_PostID) Asc() PostOrderExpr {
-	return _Post_PostID_OrderExpr(c.QuotedString())
+type _Post_Join_Blog struct {
+	joined bool
+
+	BlogID _Post_Join_Blog_BlogID
+	Name   _Post_Join_Blog_Name
+	Author _Post_Join_Blog_Author
 }
 
-func (c _Post_PostID) Desc() PostOrderExpr {
-	return _Post_PostID_OrderExpr(c.QuotedString() + " DESC")
+func new_Post_Join_Blog(dbc *goen.DBContext, alias string) _Post_Join_Blog {
+	return _Post_Join_Blog{
+		joined: true,
+		BlogID: _Post_Join_Blog_BlogID{
+			bs: "blog_id",
+			qs: alias + "." + dbc.Dialect().Quote("blog_id"),
+		},
+		Name: _Post_Join_Blog_Name{
+			bs: "name",
+			qs: alias + "." + dbc.Dialect().Quote("name"),
+		},
+		Author: _Post_Join_Blog_Author{
+			bs: "author",
+			qs: alias + "." + dbc.Dialect().Quote("author"),
+		},
+	}
 }
 
-type _Post_Title_OrderExpr string
+type _Post_Join_Blog_BlogID_OrderExpr string
 
-func (s _Post_Title_OrderExpr) PostOrderExpr() string {
+func (s _Post_Join_Blog_BlogID_OrderExpr) PostOrderExpr() string {
 	return string(s)
 }
 
-type _Post_Title struct {
+type _Post_Join_Blog_BlogID struct {
 	bs string
 	qs string
 }
 
-// PostColumnExpr implements _Post_Title_OrderExpr.
-func (c _Post_Title) PostColumnExpr() string {
-	return c.QuotedString()
-}
-
 // String gets bare column name.
-func (c _Post_Title) String() string {
+func (c _Post_Join_Blog_BlogID) String() string {
 	return c.bs
 }
 
-// QuotedString gets quoted column name.
-func (c _Post_Title) QuotedString() string {
+// QuotedString gets column name qualified by the join alias.
+func (c _Post_Join_Blog_BlogID) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_Title) Eq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) expr() string {
+	return c.qs
 }
 
-func (c _Post_Title) NotEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) Eq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) In(v ...string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) NotEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) NotIn(v ...string) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) In(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Title) Like(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Join_Blog_BlogID) NotIn(v ...github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Title) NotLike(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Join_Blog_BlogID) Like(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Title) Lt(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) NotLike(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Title) LtOrEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) Lt(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) Gt(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) LtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) GtOrEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_BlogID) Gt(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) Between(v1, v2 string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_BlogID) GtOrEq(v github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Title) NotBetween(v1, v2 string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_BlogID) Between(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Title) Asc() PostOrderExpr {
-	return _Post_Title_OrderExpr(c.QuotedString())
+func (c _Post_Join_Blog_BlogID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Title) Desc() PostOrderExpr {
-	return _Post_Title_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Join_Blog_BlogID) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-type _Post_Content_OrderExpr string
+func (c _Post_Join_Blog_BlogID) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
 
-func (s _Post_Content_OrderExpr) PostOrderExpr() string {
+func (c _Post_Join_Blog_BlogID) Asc() PostOrderExpr {
+	return _Post_Join_Blog_BlogID_OrderExpr(c.QuotedString())
+}
+
+func (c _Post_Join_Blog_BlogID) Desc() PostOrderExpr {
+	return _Post_Join_Blog_BlogID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Post_Join_Blog_Name_OrderExpr string
+
+func (s _Post_Join_Blog_Name_OrderExpr) PostOrderExpr() string {
 	return string(s)
 }
 
-type _Post_Content struct {
+type _Post_Join_Blog_Name struct {
 	bs string
 	qs string
 }
 
-// PostColumnExpr implements _Post_Content_OrderExpr.
-func (c _Post_Content) PostColumnExpr() string {
-	return c.QuotedString()
-}
-
 // String gets bare column name.
-func (c _Post_Content) String() string {
+func (c _Post_Join_Blog_Name) String() string {
 	return c.bs
 }
 
-// QuotedString gets quoted column name.
-func (c _Post_Content) QuotedString() string {
+// QuotedString gets column name qualified by the join alias.
+func (c _Post_Join_Blog_Name) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_Content) Eq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) expr() string {
+	return c.qs
 }
 
-func (c _Post_Content) NotEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) Eq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) In(v ...string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) NotEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) NotIn(v ...string) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) In(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Content) Like(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Join_Blog_Name) NotIn(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Content) NotLike(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Join_Blog_Name) Like(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Content) Lt(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) NotLike(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Content) LtOrEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) Lt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) Gt(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) LtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) GtOrEq(v string) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Name) Gt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) Between(v1, v2 string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_Name) GtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Content) NotBetween(v1, v2 string) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_Name) Between(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Content) Asc() PostOrderExpr {
-	return _Post_Content_OrderExpr(c.QuotedString())
+func (c _Post_Join_Blog_Name) NotBetween(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Content) Desc() PostOrderExpr {
-	return _Post_Content_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Join_Blog_Name) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_Join_Blog_Name) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
 }
 
-type _Post_Order_OrderExpr string
+func (c _Post_Join_Blog_Name) Asc() PostOrderExpr {
+	return _Post_Join_Blog_Name_OrderExpr(c.QuotedString())
+}
 
-func (s _Post_Order_OrderExpr) PostOrderExpr() string {
+func (c _Post_Join_Blog_Name) Desc() PostOrderExpr {
+	return _Post_Join_Blog_Name_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Post_Join_Blog_Author_OrderExpr string
+
+func (s _Post_Join_Blog_Author_OrderExpr) PostOrderExpr() string {
 	return string(s)
 }
 
-type _Post_Order struct {
+type _Post_Join_Blog_Author struct {
 	bs string
 	qs string
 }
 
-// PostColumnExpr implements _Post_Order_OrderExpr.
-func (c _Post_Order) PostColumnExpr() string {
-	return c.QuotedString()
-}
-
 // String gets bare column name.
-func (c _Post_Order) String() string {
+func (c _Post_Join_Blog_Author) String() string {
 	return c.bs
 }
 
-// QuotedString gets quoted column name.
-func (c _Post_Order) QuotedString() string {
+// QuotedString gets column name qualified by the join alias.
+func (c _Post_Join_Blog_Author) QuotedString() string {
 	return c.qs
 }
 
-func (c _Post_Order) Eq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) expr() string {
+	return c.qs
 }
 
-func (c _Post_Order) NotEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) Eq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) In(v ...int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) NotEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) NotIn(v ...int) PostSqlizer {
-	return &_PostSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) In(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Order) Like(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Post_Join_Blog_Author) NotIn(v ...string) PostSqlizer {
+	return &_PostSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Post_Order) NotLike(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Post_Join_Blog_Author) Like(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Order) Lt(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) NotLike(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Post_Order) LtOrEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) Lt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) Gt(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) LtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) GtOrEq(v int) PostSqlizer {
-	return &_PostSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Post_Join_Blog_Author) Gt(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) Between(v1, v2 int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_Author) GtOrEq(v string) PostSqlizer {
+	return &_PostSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Post_Order) NotBetween(v1, v2 int) PostSqlizer {
-	return &_PostSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Post_Join_Blog_Author) Between(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Order) Asc() PostOrderExpr {
-	return _Post_Order_OrderExpr(c.QuotedString())
+func (c _Post_Join_Blog_Author) NotBetween(v1, v2 string) PostSqlizer {
+	return &_PostSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Post_Order) Desc() PostOrderExpr {
-	return _Post_Order_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Post_Join_Blog_Author) InQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Post_Join_Blog_Author) NotInQuery(q goen.EntitySqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Post_Join_Blog_Author) Asc() PostOrderExpr {
+	return _Post_Join_Blog_Author_OrderExpr(c.QuotedString())
+}
+
+func (c _Post_Join_Blog_Author) Desc() PostOrderExpr {
+	return _Post_Join_Blog_Author_OrderExpr(c.QuotedString() + " DESC")
 }
 
 type PostDBSet struct {
@@ -1412,16 +3609,17 @@ func newPostDBSet(dbc *goen.DBContext) *PostDBSet {
 	dbset := &PostDBSet{
 		dbc: dbc,
 	}
-	dbset.CreatedAt = _Post_CreatedAt{"created_at", dbc.Dialect().Quote("created_at")}
-	dbset.UpdatedAt = _Post_UpdatedAt{"updated_at", dbc.Dialect().Quote("updated_at")}
-	dbset.DeletedAt = _Post_DeletedAt{"deleted_at", dbc.Dialect().Quote("deleted_at")}
-	dbset.BlogID = _Post_BlogID{"blog_id", dbc.Dialect().Quote("blog_id")}
-	dbset.PostID = _Post_PostID{"post_id", dbc.Dialect().Quote("post_id")}
-	dbset.Title = _Post_Title{"title", dbc.Dialect().Quote("title")}
-	dbset.Content = _Post_Content{"content", dbc.Dialect().Quote("content")}
-	dbset.Order = _Post_Order{"order", dbc.Dialect().Quote("order")}
-
-	dbset.IncludeBlog = goen.IncludeLoaderFunc(dbset.includeBlog)
+	table := dbc.Dialect().Quote("posts")
+	dbset.CreatedAt = _Post_CreatedAt{"created_at", dbc.Dialect().Quote("created_at"), table + "." + dbc.Dialect().Quote("created_at")}
+	dbset.UpdatedAt = _Post_UpdatedAt{"updated_at", dbc.Dialect().Quote("updated_at"), table + "." + dbc.Dialect().Quote("updated_at")}
+	dbset.DeletedAt = _Post_DeletedAt{"deleted_at", dbc.Dialect().Quote("deleted_at"), table + "." + dbc.Dialect().Quote("deleted_at")}
+	dbset.BlogID = _Post_BlogID{"blog_id", dbc.Dialect().Quote("blog_id"), table + "." + dbc.Dialect().Quote("blog_id")}
+	dbset.PostID = _Post_PostID{"post_id", dbc.Dialect().Quote("post_id"), table + "." + dbc.Dialect().Quote("post_id")}
+	dbset.Title = _Post_Title{"title", dbc.Dialect().Quote("title"), table + "." + dbc.Dialect().Quote("title")}
+	dbset.Content = _Post_Content{"content", dbc.Dialect().Quote("content"), table + "." + dbc.Dialect().Quote("content")}
+	dbset.Order = _Post_Order{"order", dbc.Dialect().Quote("order"), table + "." + dbc.Dialect().Quote("order")}
+
+	dbset.IncludeBlog = goen.IncludeFetcherFunc(dbset.fetchBlog)
 
 	return dbset
 }
@@ -1440,6 +3638,43 @@ func (dbset *PostDBSet) Insert(v *Post) {
 	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
 }
 
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *PostDBSet) Upsert(v *Post, conflictCols ...PostColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
+}
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *PostDBSet) Or(conds ...PostSqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.Or(dbset.sqlizers(conds)...)}
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *PostDBSet) And(conds ...PostSqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.And(dbset.sqlizers(conds)...)}
+}
+
+// Not makes a condition whether cond is false.
+func (dbset *PostDBSet) Not(cond PostSqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.Not(cond)}
+}
+
+func (dbset *PostDBSet) sqlizers(conds []PostSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *PostDBSet) CountExpr() _PostAggregateExpr {
+	return _PostAggregateExpr{"COUNT(*)", "count"}
+}
+
 func (dbset *PostDBSet) Select() PostQueryBuilder {
 	return newPostQueryBuilder(dbset.dbc)
 }
@@ -1452,10 +3687,10 @@ func (dbset *PostDBSet) Delete(v *Post) {
 	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
 }
 
-func (dbset *PostDBSet) includeBlog(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+func (dbset *PostDBSet) fetchBlog(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
 	entities, ok := records.([]*Post)
 	if !ok {
-		return nil
+		return func() {}, nil
 	}
 
 	parentRowKeyOf := func(v *Post) goen.RowKey {
@@ -1479,30 +3714,29 @@ func (dbset *PostDBSet) includeBlog(ctx context.Context, later *goen.IncludeBuff
 		}
 	}
 	if len(noCachedChildRowKeys) > 0 {
-		cond := squirrel.Or{}
-		for _, rowKey := range noCachedChildRowKeys {
-			cond = append(cond, rowKey)
-		}
+		var noCachedEntities []*Blog
 		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
-		query, args, err := stmtBuilder.Select(
-			dbset.dbc.Dialect().Quote("blog_id"),
-			dbset.dbc.Dialect().Quote("name"),
-			dbset.dbc.Dialect().Quote("author"),
-		).From(dbset.dbc.Dialect().Quote("blogs")).Where(cond).ToSql()
-		if err != nil {
-			return err
-		}
-		rows, err := dbset.dbc.QueryContext(ctx, query, args...)
-		if err != nil {
-			return err
-		}
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("blog_id"),
+				dbset.dbc.Dialect().Quote("name"),
+				dbset.dbc.Dialect().Quote("author"),
+			).From(dbset.dbc.Dialect().Quote("blogs")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
 
-		var noCachedEntities []*Blog
-		if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
 			rows.Close()
-			return err
 		}
-		rows.Close()
 
 		for _, entity := range noCachedEntities {
 			sc.AddObject(entity)
@@ -1512,15 +3746,46 @@ func (dbset *PostDBSet) includeBlog(ctx context.Context, later *goen.IncludeBuff
 		later.AddRecords(noCachedEntities)
 	}
 
-	for _, entity := range entities {
-		parentRowKey := parentRowKeyOf(entity)
-		raw := sc.GetObject(goen.CardinalityManyToOne, parentRowKey)
-		if castover, ok := raw.(*Blog); ok {
-			entity.Blog = castover
+	return func() {
+		for _, entity := range entities {
+			parentRowKey := parentRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityManyToOne, parentRowKey)
+			if castover, ok := raw.(*Blog); ok {
+				entity.Blog = castover
+			}
 		}
+	}, nil
+}
+
+func (dbset *PostDBSet) relationBlog() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "posts",
+		ForeignKeys: []string{
+			"blog_id",
+		},
+		RefeTable: "blogs",
+		References: []string{
+			"blog_id",
+		},
 	}
+}
 
-	return nil
+// WhereHasBlog makes a condition whether the entity has any Blog matching conds, by a correlated EXISTS subquery.
+func (dbset *PostDBSet) WhereHasBlog(conds ...BlogSqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationBlog(), dbset.sqlizersBlog(conds))}
+}
+
+// WhereHasNoBlog makes a condition whether the entity has no Blog matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *PostDBSet) WhereHasNoBlog(conds ...BlogSqlizer) PostSqlizer {
+	return &_PostSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationBlog(), dbset.sqlizersBlog(conds))}
+}
+
+func (dbset *PostDBSet) sqlizersBlog(conds []BlogSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
 }
 
 type DBContext struct {
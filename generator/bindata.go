@@ -209,17 +209,43 @@ func _escFSMustString(useLocal bool, name string) string {
 
 var _escData = map[string]*_escFile{
 
+	"/templates/comparisons.tgo": {
+		name:    "comparisons.tgo",
+		local:   "templates/comparisons.tgo",
+		size:    5333,
+		modtime: 1792429797,
+		compressed: `
+H4sIAAAAAAAC/8xYXY/aRhR951ccIdqalDhKHqmiqJslGxRE2kCbSogHY65htGYGz4zZUMv/vZqxDWaX
+j+UrKW+euR/nnOt7Z0ySYEwB44SqL2ZzTzIluKoiTStJAhag5nbjMPRGIZm1V6/QijDz7knBgy/4mGkm
+OB6mpKckoacEX4TxjIOi2AsVtMCiAaZ/UWj30P2r00EgJDgLIaR9ditBzH04PpIENbe/nJtUdbQiZ5Et
+fWAUjlfrdqkXhexfkkhTJBUAkKRjyfFzebc9m4dI00RFMZOSQrcVJb5L3+bSqTcxEcTd94IvSOqC499e
+GJOzqKdpJa0Ytl2hn0uYC72D9Of+UcRtzotzt1GPpd9WZut5/JnayyoL5VyufpyFZZxCHwXVVOsAXKHP
+R/xY9QJ0koBCZXvqx7/9m2X/v7yUG6iMXnyMdCe+NncWcF3XrlrX60umDmt2HVgHZTuErMPuqSjm5cQy
+aApQ+BVVdNqfWnhXbWyta32/ct8JohnOJ8Ps6Isj7OgzmrSjP8t1l14Qkwl7Bq67y+t0p8/CcxWd7s7V
+6Yb0AxF3Fq8bWLy5+rt/0+p/bbW6eIffu7e7GuB1fevym0P9+525mD6+BB9zmeB/xiSXz75KMA4pHhRE
+gKiBCIpC8rUCffN8HS4heGH9G8iduBgt0Rc5tZ33jwyEE2VgW1wzvcx9TpHORimCFso1ENUPnV9XgFGK
++xhJ6ZwvfZpICj1TgD8kjZnvaVp9odQkhWi+Rc39ktusNwLKk1tCzbeYS8Z1gOpPKt+oWv+N60yhxXik
+SOOF5XB70yNtFSmAJEnJs+vNjKdTxwtLr0DSm5P/SJIn+9m2+fXNBbyJalYC85DHrTZWNh+EJDbhn2ip
+mhgMlZaMT9YhzC9JID0+IdSCeyuMhbn2w8s03XCwCYN79719Q5/mzIOaqpRd07XFFwqojN5k3EHAmEri
+Pj0DvyngisHacTsBY3wGhSR5aT92LfSpFPFkipJlvlTmtzIq5ckXj6qSL8IVyaf+28kanyMJ5qGP0X8L
+skNVOAGYUT7veLuTz+CvU5L00VPbGu3AYCY7ozD1FDy+xI4I2p8yPrEhVMNMZBNO2v6mMVr/tHv9HlQ8
+isyYcvcOhX1YHZug+AJ4PJNOOwXtEPnoqWKOZLDc8ch3b5lnzh4zT7PF/QOrsFJZgj0M1qdjwbYrzqsN
+F6eVxhz0J5WnK3bTu06BuuIHlGifFIeDYDDcpcNguLp+5Vu5FEVUMy1M+Z2nlg2ExAuY1sn8F8aMRzZt
+suTrUVTEHLAh3ma7AzbMR0RJ/8Ju4+rw3wB0LesG1RQAAA==
+`,
+	},
+
 	"/templates/context.tgo": {
 		name:    "context.tgo",
 		local:   "templates/context.tgo",
 		size:    856,
 		modtime: 1578966143,
 		compressed: `
-H4sIAAAAAAAC/8ySQW6zMBSE95xiFOX/BSjlAEjZULrNpvQA2H5BSNSk+KEQIe5e2QRIUBupXZUVfp43
-mvlkvpwIafJca6aOYbhpJaP3ACAsatLRfOm5Yd+jyXVB2HIuKkK8xzbK7K/BMEySrRKGOLPm8R6nptR8
-xOafSZNX4s11N3rRXPLldm01R3jvtShJK3saPO/YaokDneecvirziiQf8neyhUpd7KAEQvNRRWkSIFz6
-jkWVkDamq/udk7UInLohbhuN/7NsNLHfPIqt526eP4L2dC31G3Lr3RW+GJrOa4K+EjK4i2ZZTk4LUqu7
-QRXgzVDW+dyNILPuC5CyqrXLrYRcHk40bf6En7P6owRdtscMPwMAAP//m3s0c1gDAAA=
+H4sIAAAAAAAC/8ySQW6DMBBF95xiFNEKUMoBkLKhdJtN6QGwPUFIdEjxoBAh7l7ZCYagNlJ2YYXH/3/9
+eTKfjwhZ+t4QY8+gue0kw+ABAERlgxS7S88OhwHagkoEnwtRIyQ78OPc/GoYx0niK6GRcxOe7ODYVsQH
+2LzoLP1E3ly98QdxxeelbTWH6DZrViIpcxo979CRhD2eXM9AVUWNkvfFN5qFKiq3oARE+qeOszSEyEmv
+iyohTU277n9JJiK06ha5awlenewSYj43Skzm1s3vQXsbx6XuIXJr7wpfAoSnNcFACRneVDMsp6QZqdEt
+UIXwpTHvA+4vIPP+D5Cybsj2VkLODyeenI/ws1FPStB2u8/wdwCbezRzWAMAAA==
 `,
 	},
 
@@ -234,62 +260,174 @@ H4sIAAAAAAAC/4SPzY6bMBSF936KI5TFzKL2fqpZNamK1PxIoQ9gzA22AjayL2kjxLtXhqQ/q9ldzv24
 uICtS0hhjIZgcoNLaMONol87NPZlhc4Z8onAVjOM9qgJlzD6Bs7nY2wJF9cRumAWu+A/tkOkISTHId6l
 EIM2V90SpgkbeVo/DronzLMQrh9CZLwIAJmI2reEzSN+e8dGlsuc8Gmen9RjLx9nUPyTnTRbzHPxZMk3
 y6+vQtx0RE+sz8ZSr/GOLCsP9HP/J3x5FeKvBuu6o9WiymPKztMEpn7oNBOKhZDchuJJr0Ruze/7DzbB
-M/3iFZd5/zsAAP//K5RKj/4BAAA=
+M/3iFZd5/3sAK5RKj/4BAAA=
 `,
 	},
 
 	"/templates/table.tgo": {
 		name:    "table.tgo",
 		local:   "templates/table.tgo",
-		size:    15686,
-		modtime: 1580440696,
+		size:    59096,
+		modtime: 1792429772,
 		compressed: `
-H4sIAAAAAAAC/+xa62/jNhL/nr9iGqQLKaeVt4fDfUiRKzaP3VtsGrdJDv0QBAtZGjm6yKRN0fa6hv/3
-Ax+SSInyK822wOVLHhJn5sd5cTij5RKOikme/Y7sbjFGODmFMcsIT+Hw++JWvTiEo/CS8IwvYLU6MCg+
-jca5SfFlDclkimzRFvGreHw2zfLERRTTfDoibapz+fzy69hBQ1ni2ktfPG5THKRTEkNGMu75sDwAABgh
-j27jRxxF4Q0Os4Ij8wTjmmq58g9WBwdcCGkqcLWCjHBkaRSjZlhMphljmIdaOQfyqc3yjt5Ocs8Hr+As
-I8MA7h8qNstVAMgYZU6p0girFRScTWPeJbLcqaep4LjNwt8Lk5bIkE8ZAc0x1LQWYMOaDi3ZsmsLez4o
-+Uptt/Lv+qEpoDb9Rv6VO7g51d7a1GwyiOF4SJGEF2fnlHD8yhWwjMT5NMErGiXICpBLPpnPrrJCLx0o
-fzeshDnGXIdBZSuC8yYWzyHebyPWTsBHJU8RC7U0HnEcISlfhr/kUYyPVPz9gbJRxIWY8CKLBCrPd7z3
-/SpU7gRvI2bEZvup96YdMoabNBErwFq/J+JHUD3p9UB5TgFjRmdZggnkEUdWrdD6PDF3rHXq+eEHRkeN
-Df06pRw9iT68iwY5Xkcj9HzfV1JXdbhMBi2sPmizerm2dRiGbXN3mmUyCG1fCd+Px0iSkl0YhpauJoMN
-cH57RIZeTEkiobQzUieUlDL4EoAgFVZkERkiKEa1RSaDsHTYU+OfsBbra63tDPommte4mznrr4i6n6YF
-co/KXzDNCP/nP9YZ2gnBYrKbqa+yUca9XPzcV7rBYjfZMmWeLTyqfle+ZmbdTjT4dcwKmSmiJ/TuH8oj
-JUdSMdRJRZg3qw1biattK5ndZw9wWr29zx7CzgxvGLrTJnpzkvXOEXhOp0QUEJ40iftknAxCuUxnbeGC
-4nd4FsVPQ0anJBFZdQs5FQP+FUom1VHgRCCZBBCxYSHfCOUae1dnbeEdxnIbx/6hX53f8mRLJdF3p0Cy
-3DCD3tg7yVPrWP6aRQwkL5BolBboXIsVqViWfTd0buwlMGFWFtCyhTfQeXgbR8R7I1n7P24PynguaQNB
-s0HVEqEw6f3Dse1YneaVJM8xr83Aad7t0UjWgstWUm/oXGx2p62a9tt3t7YPODa8HtH6JPdD5UMMY8oS
-y/tr/az3JJLlhi8B5gUKjiJxaa4+nJ7Cuw7KYpKHl4xd0xs6L0wereWa2/27B+Wf21Qi1Sb2cJVeT6ba
-OIofMzIEhlFBSQBzSjgU0/GYMg5plnMUmboswfYs+2Kad2V/VYWVOch3HwKNRYbyBGd1FOjU4q7yKlJx
-UKh/dM1nJq4tE6WQKTLULlnSdqMyJVpiqsS4bVbcUlaVk7WHQcszGqlWY5HZVoF8U7p6V6zQeRGe57RA
-rY3NO6+Wq7tKLATLKvoa57cxHeN5FD8q8ykX883iT+GpHaTcWg2piMP3SdIf/Fc4g3ptFgKt3eri3dJ9
-lTaKOGjX7TsljoODdqhX51CvB9KV5M1crSmg/J8/RlxecTgMFu36ap7xRxhmMyRljIaS3yNW16YE02ia
-8wI4hSjPq+c0hWaGyNLqbVbA78jo2xzJkD+G69JQBd4riUU9L4Pcd3VIlqUJRALQJK0sunuW0flAAtgh
-1+yUbwwZz087tTuuypRMSb7QLhAAoVzYTNw1jDRketKbRhtntVq6E5Z0jDD05YmyXOp96p6M2PhRuVq2
-xQRbvhg3untfvi+M9pkmDj9kmCdiW3bbru9qxCkmX1zdOCe7Zveob7Z4yr6Q7mx1LPM3tn4aLSzVYSrs
-5pVQRrMXNChKCPLwKIxOUq/X2dCCbDTOZf+lC3EZa7Eh2d/cILO3EStHTMqGmUal/oUh8gIGESuTBJBo
-hG65jY5bU8qg0JxNcYr/RD7ZLMEG2iFnUtS1kE1+OfFmhiaVA1nGd6af7giqOhGXk2VTjScwW626kFxT
-/nJgJPPd8Hwi3qy8pv8VlPOiePbQz1X2hC/mOyI0m2jgb3AIV58+X8JPhwHM/HXK+nPAXffvtgJ4xV8K
-2xXf0Yi8z14u6hT33RB9fDHdfOS7InlJ3XzcQzdnyOeIxJv9EMDs79/au88u7367vLyGn+D99YVycYlj
-bSD+uZBFQO4O+30Re357KLZsDmHaxUcLhd8l4wL/OCFyqxeXt+eHvi5QkSRVJZoMCuTtme7F2S3yxjy3
-Kthqmq1HeOvL4rf6nrxfaWxSOwpdQ7HlslIBNjKGuYLVJ3hHf47I4gbziGdUFe5isb7JCo7CtSwx7TlV
-Q1yHNCHojvYJfhNpcm9/uLDGXNV0EPdc9bjlR6UDiRvZyamKaHNB1xxz9c38S6IJu7zs1PCz5aGxqr6b
-wmp1GIDrStu93l/tYFenzyrY68x76jDwhymJPUWadZP6z3XxvwC4zoj41th0Wpe0XVdKHg1ylPc9fW6o
-gGmF04a7pfS3ej4vHG3TVXN7yVvcOZV+OiPBRlYeW1kquzZH4Q1GSZ/k8lTagOUTKZCJmrHRlvXNfCOB
-/BLx+NHoiYaKVD7up95MHtbG2bFJ/fobia6pbdnJdH6IojE9Z+P/GScRx702rkg7N74noAvMcU9AinQN
-oN4xUIJvOX07isgCWBXJAxxmBI57qnu2Xc7ctJE1Ue0aFwXqixp9BOpUcTZNU2QBFOXRWLflq9Y4GF+E
-+Wq+VE78he4yLAKgT7KxqgjC9lyqmml8R5+cbXSzgx4/ZnlyQ+efcdFPBV+hCJfBJGK1sM30jXz7czRW
-C+z2rozrE5V+hPKsOA+spZ9xcQKjaHyv8of5fZzN007rWQBHqdSKsg5lmA3JZ1zUVUCD8IhhKqvfjCT4
-VZHdYIoMSYwFHGVOwsOSsnFen8BMlgjpk+UZgUuycOAmb2PlyjROr6cnhnKqiIlygoUynHxyXpvPHAga
-xgrgnWrWlw6ke+WEnj+Tgx4dKUx1r79cZXjJE8r3lq8pbou6cZ+lUMThv6NCz5gkgvOIJRmJ8owvqsAN
-BL/WDKG9mVOI1Idg7XeKRa305gi5U0EVT9fbJld7PCY06KLy4V/WnKb8Dqu6y/bZcmUNVr4EwFQgVkp3
-gm1OWUhi6ISSpGTThPzsjx3b53znJ49dY2LHp4cH7uAX1UkV+nVctkN/Q/1BysojONgiZKvvINfybKU7
-3ze/mLMG3usG0UaqLSegtrms2XcNarvx916Sqz9nEavc77KMfX0oVWelPkib4iyw5ny8ybBrNtw5LN9K
-Y/bMfE1Oa+3PRmDNxpt5zVCV/kSE4DxfgPw8tUzpAXAKAxTpPscEBgv7++eKgywrhLAbdfx7LUWZ58f2
-GdpIzZszNYvkt2dFHH5EvjlbG8wsfxMHaV3ORHNRypjlz49g1S/W1wrq8C6/VUhbFqkqpkXYcYnTeXDN
-IiUn9Jye7NuutnIf4EbNZXXh1lSvYoWsXdUi8VasogS3LnHdN+//2xJ3HDEk/LXGfa1xv0GNazvbzkVu
-FbqvRe5rkfta5L4Wua9F7h9X5Jq5eYtcvU2Za+Rrk5vlcnFUcDoTZZFR6rqLSkfJu76KLXnvUX66K8tG
-+alr1F2qT+dopaP27ZD9vwAAAP//1v13NkY9AAA=
+H4sIAAAAAAAC/+x9bXPbONLgd/8KrCqbIxOGzt5d3QdPea8cT2Z2nknsPHbm2atyubKUCNlcU6RMUHK0
+Gv33q268g+CLJHtmso/zYcYigUaj0eg3NJrrNXnB7vPsX7T6vJpTcnRM5lVW1FMy+jO75C9G5EX8vqiz
+ekU2mwOjx0+zeW72+NLR5X5Bq1VziP+Ex+8WWZ76Ok3KfDErmr1O8fn7r3NPn7JKfXM5h8f+HsnNjafD
+yc1NRW+SmrZ38sy/rxdj2Y1nQif4fEaLuq2Pb6yWTgfTRTEhWZHVQUjWB4QQMqN1cjm5pbMkvqA3Gatp
+FazXZq/1JjzYHBzUgJrLFJsNyYqaVtNkQgXAm5IWorNY8gN8bgP9XF7e50FIAlZXWXETkatrBWi9iQit
+qrLyjovT3WwIq6vFpBaDsvtFVlU0j+WQcq6B6EVeNUGEO+EkRqxovagKIiDGoi+Me3hIfikeqmROstk8
+p7AKjFNFIMffzmkVD8GRtw7Cxhz9iBgEUJQzNotnwWwi6A0EQyIh+Ppd4t/6oTmA3lm98NVusyCt1ySb
+kqKsyYv4gibpeZEjw6oRjO3hGeLw0BlFbwByQ2tGEjJOKko4IUiRzChJipRkNSPLJF/QiJRVk8DTsiJJ
+QejXeUUZy8oi9kxIDwWsI0aQHITAicFFNksbG9jlaAsQPuGwTGCay5MmuLATT4mgiZrNUEnMUYhIEuPQ
+Byh0aJGSjTUHKSMHrYspBMXSFCSRTwnMps7KgkySPFdLVFG2yGtz9bwLYcKGOcK6qZWAXuKHswZSXLsr
+YHTH3wYEsc275mbsfYdKctc3hg/7JiTnIqZhrxeNAeGI0BgQFRieAPHgNyP1LbUJGZG6JGNK2CQpCprC
+4pUkkTSYZjRPCWwAMkvm5I6uyHiFQDj9W+dwwgKT1m4DSVzEkhwjNGsaAnMubjiLNFDvw8GRVS6hJIFa
++7+/D5bO1mgqPgvoy6boXit58v5+zVfniOuB07JY0qr+L9hVwTLcbDqROSvrR8YHIe6D0of6UfH5UO+H
+zHn1yATiIPdB6sfHpdCP9X7IPDqFftybQidsEoRN68FC4UuLQNRGBEcg7Bzpe/qIQ5HXZES+f395OtJq
+pLevMqSEvdffI+w1mxzzD58FLPSqpk/JDSUZIwmZw1/lFOQ5ozX8zIqkRtNGaUSnn6UUL+ikrFJGrq5f
+2U0PpMKfLCpWVgwGQW1Bv9aoyOcVXWblgiEKDA0uOpvXK5Jhw4qSpKKkKMmsrChvhCDPAADnL4TMB/pU
+0aX11FTp2qN0dXo6npBX2O37d6dlUdOvNYeXFZN8kdIPZZLSSpjrP5nPPmSsVnNkNKeTmqamOuJTSvJc
+PGQwMZyhYc8B4Uyj+vCQPNxSmD5JihWpaI6LQTJG/llmBU0joE2hegOJ7hdJnk0zmkqFXCfjnGpFyjuS
+cVnmapD7RanRZeSmKhdzBIAN8Oe7VRO3SVmkGSDEyE22pAWM+HdYqwht41/maVLTE2GpfU9zir+w9wM0
+w+m6rpkCnicLRiXkEolQ3yaFHOHhNpvcto2BlFgUOPO6JMl8ngs6C6j2XPJycscX9aJ8+FBO7vjjMQ8v
+GJY/LqyIOkgbs0qKG0peAF3Bz34R/0eZFQx4XjTAV/EPYDGdJTPkOmeDf4EuX7xNBZA30rKWkqygDy4v
+Bx72DZscL1zieiYnAljrKdZJjYapeBl/ypMJvS3h7x/KapbUMEz8fZYAKYLQ8z4MVejgM8A2YgiwWc6n
+wctmCMEQVi7GHGGxP4/gP5F6cniouHZelcsspSnJk5pWqoVYxCNzxmIhgzD+oSpnzoT+EzZDgNjHn4GD
+YCWCMAz5qIbauh83cA2JEAtBLmRFHMdNcdG6LPfj2JY18cl8TotUgovj2KLV/bgHHdwtAexURKWp1btQ
+Ebv0WP99dZTTIlA/Q+fnNfaEzf8lQvEA6883CEdBr+X9OJb769j4EWuEQ7OtQiXh9FCPIqLbbrYnzUXy
+oKnjCqP/vrQ5n04ZrYMS/0cWWVH/n//dRQ0vuhaQUDaVIthEVzxTFBS/Q/f3dURGHOpou23wIZtldZDD
+f3edjQHi0eaCMLecCtp671ZByf+vtrVpvLZODmxUhkI5uaOB1IIRAdwkwDBUnJppHpVvDTZFYFfZNTlW
+b6+y67jVNDX4sJVlxOQQtBJ2j8E1HLCP1oeH5FToEG65MVIW+UpYHkK9RGhgiFBDTZKKkpxOa/IvWpUx
+gPh8S8m8ymZJtcJwCDQXfUlFp7SquEkmjThurSX5Q7JiymJESHC0kdGUUCBhRnnDWVLd0ZQkYCBXdZbk
+wvTjBhB5qLKaCrwBSccEZXEXR4nZQ3RScZMVFG5lJx478rMTQPOzErwx2AiBcDaCN8BCMkJjc4wkp8UD
+/JnmAf47dH9f8xhfiwI9PCQ/lJWgJViDjNyLVajKB4YTWODbiGT1/xCETvK8fMDQGElIXSUFSybcWTo8
+JD9Bs4r+ky/DeEVSbmEw8pDVt+WiBshvYCzw1gSrfkdofBNjtL6m/6tz0RS+QZckA/jkWP4VG53aiHB5
+m1StNGDwchgJulHHUbbEXPTxIn55l83BcKcpYXfZnJEkr2iSrnAiYgaANwZeYU/p5V4UOWVMTz0TPkfn
+FPR420zC7OWdxln59ySryTTJcs0nD0lWA4/gecPTTouPv82UZI/mdDpdI+nruCeaX/7MuDP0Z2acTXrc
+osNDAu387tU/cRj17kKIXG3OQ6PEaHGSZwngJX1mP1gpuoXovR/Hbe2mtGJ4NqHEr5QICVcb0MlSKYu8
+JozWJGOE1Vme23F3W6Xi1utcx1bStC9tNm2dUCxiBlpk64XWAlrIN1jN+3Fs+VT4nschjo5lQ8PR6vMO
+LT8MgSW4YA1go8aKCoW/TCpSWudlqJMiMk+ySmumq+ur/ymUmJ6sZuQsIi+md8jKNmP9UFY0uyl+pitG
+3mw2uisgFGRFSr+6XS6ASWgxoYy8yMKY62BB8FFEcCbTO+f5JjKxokWqRtsYq5NNSUb+St4aj+BfWZDX
+x2RETs6+JyP1ZnNgv+eUfU1G8Yi8dsgLxLp6ex3CawKgXotV7Wj+l+shdt8HOq2BaYO29fTu4RHH5OQS
+UVGYk/MzfFAWynz0b9RjGUZRomizCTjzRhycAiC2wDGpqwVtCDvj5PPwkPwoAmYYOGNce45XaNxE5HKS
+FD/yF9LarG/pDKW9PursNtjEADsYbMr9zDvNMbGJMS7IORDGUmaZ5X3K8KBplYlnyioTv0P3t7DKwl7P
+V85Yt948lmcgQLd4Bn9Llqh7s7zGyC9fOVzNIpX2WjpmtI5Py0VRc08n/rEO/hJ2riEHvFNkZq8YgjHu
+IxOSQ97Sm9XbwT6AinAvKMoYB+Qhz7SxDzjux7GGJGKfwYT/P36XTO5g4Yo0CCOyFKDRExDWo9tVbU0Z
+ClcR9iLlvYUNAFpa7HBU1EtgDRV9RZxQ9y/F+UqJUyT8KD3PJnjaws8gGCkrOEyXTPXq6nqWzK+4NjJT
+jeJh9FRUqL8SSQnxLCI70Tqbwk9ypK2/C/RvgpEedRR+h43+dEyKLG+aDLSqDK5D0XOkGE46kICSlhLK
+ZRN8D/hpvueo20GJSAkxeBt35k2ojoiLQoULa3WkJ1SMa9gINYVSydpNsCYRSaobFhk0k3vQdLhhdio/
+zKDyMAJyB0CPANhhaqSx+pGJjiLmVsOkYKzgYPFpXrKGF4bjAhMEHKGl3FlKoQlf0k7oSUXWUjkVywbb
+i2+prOYbKqWs7mR4BczP6FaWDwCzT7mHsbdWy9ux90A2wDPqvXjAXf2L8qGXAQSsqnzgK4enFGpzXKL7
+UQVAsjDsEeKf+DkxDZJpTSvz4DXCo9rL7F9UhF0j0h23DF41z5j9uZX341iO2y3wESmNiMbA0AAOJM2t
+2I2DQFeNn1+T8UqekRuHoOVUgUb1YMUDMyZEC4/iJaTO6JtxRZM7WqGKkF3JbMFqMqZK55RTx//j++R+
+zFsWJbbG7jQlZUUwRs2DTlnNaD6Nhyxep774TRYWO5jhRGM8jN/uGKcueV+xAV2g59PAiF6Hphfl3YMG
+CxZZHqnN6DhTOBcjOG5s2Lkrsc/owyeVajHIIxbUZoq7bWt9kBSxsHfdM3xDjskcDiDzVWC+kSu/6ygV
+zxIxqYAsCay3K0zACWC9bHLZWreIz5Cb8U/IEdGzhIbBS4XZvlPEEWQ2zLGcsSm+5sj/RZZ3JThDsrjK
+cOABaEaSPOcW5yypJ7fgneC5oCmJxiuSECaP8kXAdryYTlFELIo6y8llsqSnt7BnmDrAEIGois7LqmYk
+mU55+BqH4zLA7Wb81v0KoVhlCJPRmifAwAMentMcjOKKRdK3KisrMi4ShaTO7JJkilQBjKfMWTM3PBQJ
+Ep+AdBd8tusd0xUgpjQHOCZMyS8gqACLkBwfW5EY3kOIoQtxPoBdAw1FzSQijUSEiHd9X1Vnpc7aFpGK
+DaE5o7YpM17kd+9hOYKRgttqxzwCerSqLGTWtontPy1CWmnhu0yslpaz4mnu6AB460hscbKEOfdCLEOr
+5nmlmQjvkesWfRQdOIE8tOBuBIwZqZP6IHQcb1ABHAJCD20xUU9uY86pwlbRWU4p/vXNSYQnEwCKMsFv
+ts+920zhsd82U2C23GYWcAWklUcfiy8VAUQbY9WzKSnnJGNuTp65st9Jnszymh8uTqty1uDkTgbQa1DO
+1RUDx8fTwdyGIuebugDUMpqeYmxLgovICNl2ZBJJCHojEObE3QcBNuJsb6+92TqWO1rO+/wxsZxtd8I0
+1iI1ydVR2q5pIqNgnpiZRqEdBO9MNljwO0bCa2hx7bBZl1/XRwMbQNOnacGgPQiAAPv8/7fRbiGA0QTJ
+8iocbRUIsIZTwgphEZzdPgECTQoVI3iJoLelgXiOfZXJ27F0iCGwSCONvJVd7JjXDuziBs087DIcG8Ob
+GTDqRfkQNP3j7qma67frbG0e8Ey4G6PuPLm/KB7q9Pa+28KxUqYtCC8BtUN0RYTd59xMvigfmF9ViuYC
+2tXb68iQet00VJPYgVVU3pTKUAJlzMSm7zCTsaM/8IGvHGObG7rOSZ7MtwpduQHiDi0DIz++Q2keHnI1
+jaagDFLZKRYik0hcVCDFIs/hPWFZilFflQvByCQpZCiLd1HjGGip8Lx+FrUF5UcNfEaWgQP54JEr7FWw
+A4+AO0L2kYHW0Ji6L+DiUQ+A2DZKwAP2yY4FnLEU61StF38aStU5KpAxl1ZR4B469M9cNZd3SVqSJDGn
+PjXjrCK3EqEY2wKbuVvCSTB0DD/Rid9gZQaC4hCLz1rvXklA6wAXSPUxqe4+cbSMyKAEEImBrL3MJirO
+eUYfLiflnJ4mk1sz1ybcDhc2iU/S9HyMlhB/7ZjB9vqKyw4Wtyk9wCZR857DVprg4KApu5Vhoa6EoEjl
+V4TNi1jyXrPMkM0KkcSC7mine2GJasUQOzuWCgXopI964WrW2mIWeZrJl0cznblCAtYVtL0281Q2wxj2
+8BBPNHBIzCAGtiET4Bt+054vFxEXUazQyxfuoJ5qHLmb+Ymff/xMV6qgSANf7GikOQWhhb0dfRGDwXmK
+M5RKp4IcrMZofiQBjN1uW8xs7DaelLGK5jz18bygn8uPSbG6UAngbza+HiLBDFystrQyhaI/S6yBppMr
+1v7IRRrw/VyeF/RbQhop/Y3hzAn9h2WPTWe2/1tum/KNKA2lMLR0jFc+qLZ28qIrqNDE//XX7p1pb2SO
+qjIX8WdEmv3cEK/lsGIvoVIMA5r/zUhiqhVxJisu3zKS1c37txCXKVpu8sbdroZtvavQlqWAsin5U0dw
+qyt3xZj7ztc2W+A3L1KqbNFOjDjZP5cydCVjivJ3fZvUIlg4XjVoxuPM1g0eFdIWv0lKp8kir9EgMC9m
+e87bs6l6mzG88/Mmp8VNfYtAL4xodcbQixGRvoiwrJhQHtmEQww8/ucGSELYYow4d669okAgMYCLikb1
+FG+RBrGL7C2kWWL7RTYvqXs9U//u9/ip7VvfGMPruDo94eymfy8rLxX5J8LVqUvCaG26d12VLTabtd8R
+hP+D47SxrzvwN1yui9YYFAWw9WruXnewbzrwzjGsprzqoLN7z30l6jiML746dT5obt2vc7PmRWeFo5Sy
+Ca8boMuntQAaXJ8imxIWI+CGvGJOQQ1PKBpbHGzM43Mx4/+4PD8TB+hGqkej5JrxLu6dktHYLLcEFHGi
+dCMNopHYz6fbUi0L2MNdhjEzF+He+kWtX1AmIJs2aNBW2aJZWaBRGUSXe3MrVjUJJAk4MWYS9pePsyk3
+4TogVRcAm+We3FJt/nE7azxN4rHU6+ZwHL5VDKNjBBvRlnHu5ThUVTRrwu+q2SHLcC2KZDbObhblgukz
+VJrylsyPIe2iM7ds+M5xOQaAzJP6tkXUQLNPSX07WNIoWF4Rw/lSAn3Pt7SY0Nzt/xjVtzab9RxFRwyw
+wk3ncI9SX0uPKMCFcr/JactChDwhNqkJICEywUdJPB75l1h2hxPaW6uomknztVtew3i5tqgfTGyTLAJO
+QTaKECOTWDYmf0sY+PngU/daJ+1UUsgIcB3o3NFVOzYg45KsYI+wcAolBbMDKb6w6zU/Z+BbqaazeZ7U
+lIwm5WyeVBkrCzYiQZpNajICNEYc8RFeThIPxIZSj8gIK2fxt0FZqRbqcbNPSEZnIuSuIconZKRr7pok
+GV3q6Y8sYoRkY+laN1XtktZoGfIkb11js5ySJalLnj8rigtaBYJaRDiFKmnrdXNeSrPYdT8bbG5VvFxP
+1P2lyF+RTFkScsDLxUzAxmqJaeqdXpKmIF93meJJmvIpDlvP/eetiw4Cw0q+RSPrNfm/Iz9hFE9zQ8H3
+l392Awqr+W2Jtd5R0yRntHWfDyre1jsGREM2TXVoLL+XPxczv8sWrNceGP4D3Qm06M5fvx8rM0g1JWwx
+Ywa3kXIpLmjAbrsfiwQv8FSRD4sSX8ZtE+lM/t59knB8siTelvhenxpY1zgiThZxl45fDXm5tKINPHW3
+jS1OljctSzPNy6Q172QSnyxvhi6GbkqSJa2SG/poC2JiMXxB/FPjSyDe9RH9ZHmzO9HVirUUdTUFlH65
+Hl3+8jEA8WNKoxCuQLPF7At/IQXYpmPBdxr75L9+9I+dLG88YxsCzxYV5+L2RYuo+JgV/aLCFPNt/Pkx
+K4byp26qy+TOsiKbLWYyp/IRWNVEaCfZ0THrhvQw2/ax8ses2J2VPyZfH2m9kq+D1yv52lyv5Otjr1fy
+9Q+6XsnXPdZLLvaW2//jT2f+7T/LiqGiRyG+7dgn/69l7ORrr+gx/nzCIivagzcKJNgevFlO1D6oMsKg
+CNkIhYqGFpuIMd3RvhjtzOBCd1lMA4CvKqYo7mA5qkaX5sIZL/k7PfUjtB4jz0ldGwGcczYfFY4GksGO
+nY/ZUVfo0Wp7z47cUh9tOVReaKEG11GUpDUw7nKEJzYuQ+OKCjvExp24ON+yvSFwqxbzngFuXwHmncK9
+v30gtDNCCavCGWjv6OjWoct7Gbr894qsPLIPHbix9PAJnOjGIHYB9D6lRWdjnv70In4Pf7bJguY5GfZs
+CTf7tpUecwZd0QwRQD7iAy2X12vZyB//NtHg7TQeTRhN7WbA1q21HrMJ9QJryzTl2vfvLqn7+SZFAN1n
+cIXz7uNLY1rOQRsuW0pTa+Zbn3K+GWAUCOHtUTOaYvZMmtzlmQfngTPK6m0n4bCg2Ze/2mkKB8Pzx0Rf
+kWwJA2BWkDlqs/R0J8W6Er+ecLRmxtaTT+3xKemYoOYe9Fdmf+U2U3uU0VrdZTcbtFVC3zgF9gZmw2+3
+8ffa1e4h4xt1Ls1oHbvbHg/EsD6b3jXrrkP1bW1Xt2zdtt3T8UTv25zRb3s+WwnVAQKJE6FrMx1b2+kH
+Wk9uafXDopgEvO8Unvh6hvuKr98ft1Zh90ch2x9hTYXRiT3bXB/jwybcqsXWTbHa4wN5JGNfbsjwkQe4
+QZw6g2V2R7WQHlx+Khit4EjVuaITmnrHuPRt5Abyrvj4fAongvJLlnN4TjJ8zSBIpwpl8wAm/ZoxLF4M
+d1AnZTHNswn+Hq/Uz9MyZ6SsmhWTxAdy4r6J/TL3Tyyyh2gplDmgkLkG0lbQXLdoK2yuW7QUOO8gP5+g
+Ir9RyjxsFB89r+Qhtb40b31FqJziC0YyxkNXPHyM56wyfowNeul+XvWXr9z2E2IoQ84rITPES8bHCVWy
+JRy/FWnXRPNcTxSFhZ4p/LndRE+wIOBTzBQg9071rKw7pgpPSCYWsHcqZyWeRKTkUachoZpR8lYU7JmS
+q2sfKs0vQ6lv+fLe5mZ1Wqptm7bvV/t6m4Cp9moKf/pqH/B26qsRouCrWJ3T81/OPgevQhzOqPObFKko
+9xq1fLCUZIxfj+9dP6PI7JbnDRI7OGLAsUZDFkt+KamtFq0Yzf85KiHQ9lFa4nMFuygt3tVWWo0D3K0R
+4vVUdkKId+1A6PAVKQv6pi7fzMxbIoyM6U1WkFeHPDo2zBLvm0irPeY/GMSvapFXpo33DssHwYVO8Vxf
+NVXXPe30uwBwCkLn3FB+6CQiJV5uEl3j5jV6dUf5T+VdM2OcAyfrjXmJH/83uc3y9KJ8+JmuzqcwBDb1
+rKH8CNzPdNWE/xLffkzmvIF+D//QTBPnL0BRy2yzz15+pqsj4q/t27yO6K0+33X7y+j4oqJTjAiICvTQ
+zao77+04kj0dP/GILGNxocxkl8g3snufzDkn2piLc3goymvz26biwzfie3345FQvX6M8JH+sLqFJXhKC
+vyhP94Qg7q9xnLQKka0MLhG3Ui1e49BWVlVJNon/ljBxmZrn2yVVmhVJntUrtZd5gqt7O6Y5GV2xuPGO
+g9BEd4tftBJIwfS9daE2Lxn7ernXjZdJpcZ+L2kp9rsSSGaMnOz70cKml9X66cLWIusaCp/WqSpL1TJp
+m9ht1R6czxJ2yAB5ExxpdGpcc/dt5B7Psmge5fbsYPinvpnYCbshAcPQ/HqdVd5iSMHVrqKr9tXrRvkL
+jemwChiPg4718/BQbChG6rLJ9sBqNJncksntorjzIWFNwyye4cJqK6PQWU1jNwI3wBiTbhGajZnbCFpV
+JlzBaUA/POTuG33IV7LQBm8ub8tMszwXBZAbEXv4h8YMDCZKtAYNOnoKTUjzom+W1D87Qy/0qwn4VyVY
+totN4h9p3a8uDIANRgZtrs2r5CG2a2qG3xHLnjIIXdE5SElGqkR/glcwMy/qw2CHT0Fck1myImMqaY70
+ZxRyY/NG2QiLKhUtDKXs1QGRqPo0pWbdT3MlsohIc0eWMZk2FsEdlnt80DIOvOM2x2qKRL54cUtUVA7V
+WTRiY5YCfsGJ1XL7yjqDMo9f3LERFI5+aTjX1imyzlNo6FzVuSWxxsyraXSWNwmNiWw2yAOMeMnEDUDO
+MvLWtq74eHhIPqhaPaBs1PcTi7LmH6VL+a04/p1owWzy9nV9S1eSN0WFH+N75jaOzmm513cSPoURvWhQ
+WTZS1ciNdmb+BG9lfpdUxBY6Iu2oR80am3ZpFtwMvUQeFvjqw8KOinmoEHpI3AhTWK/XiMERpz23v9gR
+/58Rr8ibHdu/jNqgejtWeaw661pj6hn/DI7+Hbq/r52PCxgTlbWJsDzeJ1p9Sipa1HzpmdRi3mWrS1Jo
+paKMBdt1jbsIY48ZFNYncP1kQLzIMSkac+gap0zSJ4wemIVc+RUnZeTlMR44casOB+N1pgScHb6qwQew
+P3RSZH0UUFh8CwGUX3+1Hd6WWorPYZVvMqwyx83eE/NoCXhkg6xaawQRO2+1aje2PvQftRmi1Bu41+/d
+z3u8kxgY0lrUdnetE/8XqaGAdVakMKlkRmtaMVJqi5dPFU8xoUqaJHAyw5m8VdEPITf/6pT8h4avXzer
+AE7MqbmnEl+cSMGk4TXv94kSgf/rYyQ9DGXSAwI0OhKhzPS+EI389oy+EWCF0TgdT62gSh63h1X+ntW3
+nxDPwOK2SGAfWgVPi9QKC50U6VoP2Kgi10l8BdD4IliRRmTi+ygA0ErWwtVBKQzmiNCOaiqaRYpQIm2C
+t9Y62kIkjz3hlujAI106BeRV49OmewaYrDDSLgJLUtl+oj6itDX2UjC7InkbebzrPMTe1/zhi/aJ5X/k
+nWzFu/J4l4jXbggc+CNcSlq0RLayqQ9ZM64lIXTFs1pjWcMIZnXVRxHCQbQ8TfM7W8uMZfgJg9uqXNzc
+8hAI90DZROoFhLBVDVacsHFCLSloaRH0XHWIDBu5+O8RGWtExdQqPGYcTIS15HS2jW1ps+LfMsr19pED
+XWDqS1Xz8qVw/IIsJH89VtaKvyf8w+/Ted9uOsNqhtYUT6LOINtvHmWzbw/Jg/ZPFU2zCX7nUV4ikvcs
+dFxk68s9ZKR08sjK5xvJ83oeQgvd4hqt6QDQApMBeCP9eUp/ooD8YOoL/XlHddGmM9SomjeDjQAzNgON
+VfnQB+WifOgEsVT3rTqA4KWsVjA6rCfxab8IBI6fOLPlQCyfDzzRNXmRybsbd3Rl8Sz5B8/fORrJt7YZ
+8Q9fUjUiT+y5mqBABH5Ryzn6h1GUzerAv5Hq+5QqxE99H/IUuW9YcF/dFD/75cMHIxJqD2ESbanSiYxL
+ccadB+nzmxD4t4gDVk3aozjm53FcjIOXy3gZxhJIeNAWVjbYX2Gpv8xvionxSreAzGR41hJPI1kBm099
+AFoU6twnatyfkqOQEwgHu4ZPzWI2fLZABqbTm57Dd8/hOy4xTMdBfdIkUjjt/o3Px7AVEUNoxVG9MpLp
+fqYrnkQN6QjcNAzyuHJtxOsDv6fITbmjY3t/uC6eYXNgB9fqCJw3kpECxDc86PEiHaukkZxI70mQ08JQ
+TyH5i9SWQnWJGKRqQd6KcmXo+4mygo6WYBDE4parpdrkxMqpOISTy9J5uoADKTZsfgIkJAFEZX1K9Nqr
+VvzFVPL+jzyZbNu90fs+9xSRp5vPU267clErH2NbJPdKfisX9ZWxW1yWgsjsnnvYkivlovbsmo4FV0AH
+nU70nUyYpxK2vdESfht8NqElVLtpakWwmpanPFBw1yDqFUeRrtqBJe+HCBBd8JOUU0XmToHR+uWsAbtO
+0G/4PnM+ruLtuLbSF/sUqty/jjatnvCkpTLPWJo7xLxFxMPDbmxNRY2x0R1dcZZBbJuB1aFMKAYzE/+a
+7BhG3V+VUKrOsMvFBQXVEusEHx0T/ZlE7+1b1cy18rHQkgdbbOOgy+sw+by3fdJPxditKadwhADYwKfq
+RLKI9mCtQOHgs5OgamahDsxA1QwCQeLYEhz8RaCKs59cEg91HU82dIDwHNKWBfELUxeEmU5qvcBbN+9W
+nZP4946/K0baOf4uIfzG8XfB4+KDpZzF1WRccYglEobmDgzS0ntmEfRr7E6tDV9ZNaJOuycAaOXXZ2xx
+QuJ3rWB01InxsmlumSrPKlHjxh+dyCTELSF+WRZ08G0m/93959tM/syO57yb5+tMT3qdyWa2re8zqd38
+fJ/p+T7Tnukmz/eZnu8zOf+e7zMR8jvdZzIVwwBFQQbeaDIUhgmxwcuThNVQCNvK9/BnF7TkffQkFAj4
+w6/tfAv5BH6D3LHaRdLBNka7t6jVs83+nCr/bLJ/kxUIng32Z4P92WB/NtifDfbnAgT9BQhQW3TVH3g2
+1vdI/u201aVBv1XBsJY6r8/2umUSJiBl5KeX8HsXWPcVX2MeaJ4Vd3bq507mMjy3Uo2leevLNDbs6M/8
+BooJ9kWmU49b1KiN3pALU/DYwg86bYOhbfB3IajqIRR3v7GvxDH9vTyloSv7mzlAsALM8l8+qDV5avfF
+XH2f9/KnAQcOrRXUfNNpeBvGy+HOhtHJ52sgRcHBgD++KYfCmtiT+hMevv/N3IpulHrukv7ejo6WXs9u
+jnJz+I7bw7dBAH8QhwZ0bkMl5gSlSZsiHKoMtwwebq0WdzM7hgUEeff2nJmePeb02LR5e23RQVOlXKgl
+6tOQnLEakjk38vqghbOUQj2anBDkjb3UrR3PypbAXttEGrrReOnoRp/P5dGTBgBXT+4cl9tHvVn4NImy
+q4rbI2y2n0bp4finDaENEeh9gpF4r/8+iq55PPR8t8h3DLE9RZitVzPtvghekA5Bdgu/9Ybg/Cp/z1Dc
+FuE4f5DxjnqmaPoXviAjvKdinizw+BjD9YL2l3ozYrdyfR9Bz+9i3RO3sAwoeTNG8nhKnui6Eb2BTq45
+HeUbfsc7t+5D32Lr9eLwujXo5glqbwxIwPiIDrTPFX8uLvtNF5f1fvMCvqeTpt4xuMRZ8vKqfNYoShmt
+5IedMGRbkqZA6f+wjn9Q36ec+NBxHPspKZZCbIfutXI/0SEi1OobS17HOmopQLV7KKOjelaLMNs5RrHd
+UJuIWDu4bcIDA5xcwJTFklY13jAIvEHMcEsSDPUhm8PjjrQD2YNxkLVq5CXJCzorl/4P4i0K/95JaU71
+1plW5WyXrdM+8O+xe4xP27TsnudwfF/ccdvAyMDDliPSzu87HRwYO8CuWPAtJUC2VVT6/wMAc9W50tjm
+AAA=
 `,
 	},
 
@@ -303,6 +441,7 @@ FbqvRe5rkfta5L4Wua9F7h9X5Jq5eYtcvU2Za+Rrk5vlcnFUcDoTZZFR6rqLSkfJu76KLXnvUX66K8tG
 var _escDirs = map[string][]os.FileInfo{
 
 	"templates/": {
+		_escData["/templates/comparisons.tgo"],
 		_escData["/templates/context.tgo"],
 		_escData["/templates/root.tgo"],
 		_escData["/templates/table.tgo"],
@@ -17,6 +17,7 @@ import (
 
 	"github.com/kamichidu/goen/internal"
 	"github.com/kamichidu/goen/internal/asts"
+	"github.com/stoewer/go-strcase"
 )
 
 var requiredImports = []*Import{
@@ -164,9 +165,43 @@ func (g *Generator) ParseDir() error {
 	for _, entityName := range entityNames {
 		g.pkgData.Tables = append(g.pkgData.Tables, tables[entityName])
 	}
+	for _, tbl := range g.pkgData.Tables {
+		g.walkJoins(tbl, tables)
+	}
 	return nil
 }
 
+// walkJoins adds joins for to-one relations of tbl, their tables must be in tables.
+// An alias is suffixed by "_join" while it's taken by tbl or other joins; e.g. a self-referencing relation.
+func (g *Generator) walkJoins(tbl *Table, tables map[string]*Table) {
+	aliases := map[string]bool{tbl.TableName: true}
+	rels := append(append([]*Relation{}, tbl.ManyToOneRelations...), tbl.OneToOneRelations...)
+	for _, rel := range rels {
+		refeTbl, ok := tables[rel.FieldType]
+		if !ok {
+			log.Printf("skip join %s.%s, entity %q not found", tbl.Entity, rel.FieldName, rel.FieldType)
+			continue
+		}
+		alias := strcase.SnakeCase(rel.FieldName)
+		for aliases[alias] {
+			alias += "_join"
+		}
+		aliases[alias] = true
+		join := &Join{
+			FieldName: rel.FieldName,
+			Alias:     alias,
+			Relation:  rel,
+		}
+		for _, col := range refeTbl.Columns {
+			if col.JSON || col.Embedded {
+				continue
+			}
+			join.Columns = append(join.Columns, col)
+		}
+		tbl.Joins = append(tbl.Joins, join)
+	}
+}
+
 func (g *Generator) Generate(w io.Writer) error {
 	if debug != "" {
 		enc := json.NewEncoder(os.Stderr)
@@ -283,13 +318,32 @@ func (g *Generator) walkStruct(strct internal.Struct) error {
 		col.ColumnName = internal.ColumnName(field)
 		col.OmitEmpty = internal.OmitEmpty(field)
 		col.IsPK = internal.IsPrimaryKeyField(field)
+		col.JSON = internal.IsJSONField(field)
+		if col.IsPK && col.JSON {
+			// a serialized value is not stable as a row key
+			return fmt.Errorf("goen: json column must not be a part of primary key on %s.%s", strct.Name(), field.Name())
+		}
 		col.FieldName = field.Name()
+		col.FieldPath = fieldPath(field)
+		col.TypeName = strings.Replace(col.FieldPath, ".", "_", -1)
+		if embedFields := internal.EmbeddedBy(field); len(embedFields) > 0 {
+			col.Embedded = true
+			tbl.addEmbeds(embedFields, col)
+		}
+		if col.JSON {
+			// no typed comparisons for json column, FieldType is not used
+			col.FieldType = field.Type().String()
+			tbl.Columns = append(tbl.Columns, col)
+			continue
+		}
+		col.SumType, col.Ordered = aggregateTypesOf(field.Type())
 		pkgName, pkgPath := g.safePkgImport(field.Type())
 		if alter, ok := field.Type().(internal.TypeAlternator); ok {
 			col.FieldType = alter.StringWithPkgName(pkgName)
 		} else {
 			col.FieldType = field.Type().String()
 		}
+		col.ValueType, col.Nullable = g.nullableValueTypeOf(field.Type(), col.FieldType)
 		tbl.Columns = append(tbl.Columns, col)
 		if pkgPath != "" {
 			g.addImportAs(pkgName, pkgPath)
@@ -298,10 +352,19 @@ func (g *Generator) walkStruct(strct internal.Struct) error {
 	foreFields := internal.FieldsByFunc(strct.Fields(), func(field internal.StructField) bool {
 		return !internal.IsIgnoredField(field) && internal.IsForeignKeyField(field)
 	})
+	// another table column fields for each one-to-many relation
+	refeFieldsOf := map[string][]internal.StructField{}
 	for _, field := range foreFields {
 		log.Printf("analyzing struct field %s.%s as relation", strct.Name(), field.Name())
 		var refeStrct internal.Struct
 		switch {
+		case internal.IsManyToManyField(field):
+			log.Printf("found many-to-many reference field %s.%s", strct.Name(), field.Name())
+			typ := field.Type()
+			for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+				typ = typ.Elem()
+			}
+			refeStrct = typ.NewStruct()
 		case internal.IsOneToManyField(field):
 			log.Printf("found one-to-many reference field %s.%s", strct.Name(), field.Name())
 			typ := field.Type()
@@ -309,16 +372,15 @@ func (g *Generator) walkStruct(strct internal.Struct) error {
 				typ = typ.Elem()
 			}
 			refeStrct = typ.NewStruct()
-		case internal.IsManyToOneField(field):
-			log.Printf("found many-to-one reference field %s.%s", strct.Name(), field.Name())
+		case internal.IsOneToOneField(strct, field):
+			log.Printf("found one-to-one reference field %s.%s", strct.Name(), field.Name())
 			typ := field.Type()
 			for typ.Kind() == reflect.Ptr {
 				typ = typ.Elem()
 			}
 			refeStrct = typ.NewStruct()
-		default:
-			// TODO
-			log.Printf("found one-to-one reference field %s.%s", strct.Name(), field.Name())
+		case internal.IsManyToOneField(field):
+			log.Printf("found many-to-one reference field %s.%s", strct.Name(), field.Name())
 			typ := field.Type()
 			for typ.Kind() == reflect.Ptr {
 				typ = typ.Elem()
@@ -344,10 +406,15 @@ func (g *Generator) walkStruct(strct internal.Struct) error {
 			}
 			rel.ForeignKeys = append(rel.ForeignKeys, &RelationalColumn{
 				ColumnName: foreColName,
-				FieldName:  foreField.Name(),
+				FieldName:  fieldPath(foreField),
 				FieldType:  foreField.Type().String(),
 			})
 		}
+		if internal.IsManyToManyField(field) {
+			g.walkManyToManyRelation(strct, field, refeStrct, rel)
+			tbl.ManyToManyRelations = append(tbl.ManyToManyRelations, rel)
+			continue
+		}
 		for _, refeColName := range internal.ReferenceKey(field) {
 			refeField, ok := internal.FieldByFunc(refeFields, internal.EqColumnName(refeColName))
 			if !ok {
@@ -355,19 +422,235 @@ func (g *Generator) walkStruct(strct internal.Struct) error {
 			}
 			rel.References = append(rel.References, &RelationalColumn{
 				ColumnName: refeColName,
-				FieldName:  refeField.Name(),
+				FieldName:  fieldPath(refeField),
 				FieldType:  refeField.Type().String(),
 			})
 		}
 		switch {
 		case internal.IsOneToManyField(field):
 			tbl.OneToManyRelations = append(tbl.OneToManyRelations, rel)
+			refeFieldsOf[rel.FieldName] = refeFields
+			tbl.Aggregates = append(tbl.Aggregates, g.newAggregate(colFields, rel, "Count"+rel.FieldName, "count", "", "int64"))
+		case internal.IsOneToOneField(strct, field):
+			tbl.OneToOneRelations = append(tbl.OneToOneRelations, rel)
 		case internal.IsManyToOneField(field):
 			tbl.ManyToOneRelations = append(tbl.ManyToOneRelations, rel)
-		default:
-			tbl.OneToOneRelations = append(tbl.OneToOneRelations, rel)
 		}
 	}
+	aggrFields := internal.FieldsByFunc(strct.Fields(), func(field internal.StructField) bool {
+		return !internal.IsIgnoredField(field) && internal.IsAggregateField(field)
+	})
+	for _, field := range aggrFields {
+		log.Printf("analyzing struct field %s.%s as aggregate", strct.Name(), field.Name())
+		g.walkAggregateField(strct, field, colFields, refeFieldsOf, tbl)
+	}
 	g.pkgData.Tables = append(g.pkgData.Tables, tbl)
 	return nil
 }
+
+// sqlNullValueTypes are underlying types of sql.NullXxx.
+var sqlNullValueTypes = map[string]string{
+	"NullBool":    "bool",
+	"NullByte":    "byte",
+	"NullFloat64": "float64",
+	"NullInt16":   "int16",
+	"NullInt32":   "int32",
+	"NullInt64":   "int64",
+	"NullString":  "string",
+	"NullTime":    "time.Time",
+}
+
+// nullableValueTypeOf gets an underlying type of a nullable column type.
+func (g *Generator) nullableValueTypeOf(typ internal.Type, fieldType string) (valueType string, nullable bool) {
+	if typ.Kind() == reflect.Ptr {
+		return strings.TrimPrefix(fieldType, "*"), true
+	}
+	if typ.PkgPath() != "database/sql" {
+		return "", false
+	}
+	name := typ.Name()
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	valueType, ok := sqlNullValueTypes[name]
+	if !ok {
+		return "", false
+	}
+	if strings.HasPrefix(valueType, "time.") {
+		g.addImportAs("time", "time")
+	}
+	return valueType, true
+}
+
+// aggregateTypesOf gets a result type of SUM() and whether MIN() and MAX() are typed, for a column type.
+func aggregateTypesOf(typ internal.Type) (sumType string, ordered bool) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int64", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint64", true
+	case reflect.Float32, reflect.Float64:
+		return "float64", true
+	}
+	// a type name may be qualified by the package name; e.g. time.Time
+	name := typ.Name()
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return "", typ.PkgPath() == "time" && name == "Time"
+}
+
+// walkAggregateField adds field to the aggregate of the one-to-many relation referred by field.
+func (g *Generator) walkAggregateField(strct internal.Struct, field internal.StructField, colFields []internal.StructField, refeFieldsOf map[string][]internal.StructField, tbl *Table) {
+	spec := internal.AggregateSpec(field.Tag())
+	var rel *Relation
+	for _, other := range tbl.OneToManyRelations {
+		if other.FieldName == spec.Relation() {
+			rel = other
+			break
+		}
+	}
+	if rel == nil {
+		panic(fmt.Sprintf("goen: aggregate must refer a one-to-many relation on %s.%s", strct.Name(), field.Name()))
+	}
+	var name string
+	switch spec.Func() {
+	case "count":
+		if spec.ColumnName() != "" {
+			panic(fmt.Sprintf("goen: count aggregate takes no column on %s.%s", strct.Name(), field.Name()))
+		}
+		name = "Count" + rel.FieldName
+	case "sum", "avg", "min", "max":
+		refeField, ok := internal.FieldByFunc(refeFieldsOf[rel.FieldName], internal.EqColumnName(spec.ColumnName()))
+		if !ok {
+			panic(fmt.Sprintf("goen: invalid column name found on %s.%s", strct.Name(), field.Name()))
+		}
+		name = strings.Title(spec.Func()) + rel.FieldName + strings.Replace(fieldPath(refeField), ".", "_", -1)
+	default:
+		panic(fmt.Sprintf("goen: unsupported aggregate function %q on %s.%s", spec.Func(), strct.Name(), field.Name()))
+	}
+	aggrField := &AggregateField{
+		FieldName: field.Name(),
+		FieldType: g.typeString(field.Type()),
+	}
+	for _, aggr := range tbl.Aggregates {
+		if aggr.Name == name {
+			aggr.Fields = append(aggr.Fields, aggrField)
+			return
+		}
+	}
+	aggr := g.newAggregate(colFields, rel, name, spec.Func(), spec.ColumnName(), aggrField.FieldType)
+	aggr.Fields = append(aggr.Fields, aggrField)
+	tbl.Aggregates = append(tbl.Aggregates, aggr)
+}
+
+// newAggregate creates an aggregate of the one-to-many relation grouped by its reference columns.
+func (g *Generator) newAggregate(colFields []internal.StructField, rel *Relation, name string, fn string, colName string, valueType string) *Aggregate {
+	aggr := &Aggregate{
+		Name:       name,
+		Func:       fn,
+		ColumnName: colName,
+		ValueType:  valueType,
+		Relation:   rel,
+	}
+	for i, fk := range rel.ForeignKeys {
+		foreField, _ := internal.FieldByFunc(colFields, internal.EqColumnName(fk.ColumnName))
+		aggr.Keys = append(aggr.Keys, &RelationalColumn{
+			ColumnName: rel.References[i].ColumnName,
+			FieldName:  fk.FieldName,
+			FieldType:  g.typeString(foreField.Type()),
+		})
+	}
+	return aggr
+}
+
+// walkManyToManyRelation fills rel with the join table and references to refeStrct.
+func (g *Generator) walkManyToManyRelation(strct internal.Struct, field internal.StructField, refeStrct internal.Struct, rel *Relation) {
+	rel.Through = internal.ThroughTable(field)
+	rel.ThroughForeignKeys = internal.ReferenceKey(field)
+	rel.ThroughReferences = internal.ThroughTargetKey(field)
+	if rel.Through == "" || len(rel.ThroughForeignKeys) != len(rel.ForeignKeys) || len(rel.ThroughReferences) == 0 {
+		panic(fmt.Sprintf("goen: invalid through table found on %s.%s", strct.Name(), field.Name()))
+	}
+	// linked entities are identified by their primary key in the scope cache
+	refeFields := internal.FieldsByFunc(refeStrct.Fields(), internal.IsColumnField)
+	pkFields := internal.FieldsByFunc(refeFields, internal.IsPrimaryKeyField)
+	if len(pkFields) != len(rel.ThroughReferences) {
+		panic(fmt.Sprintf("goen: reference_key must be the primary key of %s on %s.%s", refeStrct.Name(), strct.Name(), field.Name()))
+	}
+	for _, refeColName := range internal.TargetKey(field) {
+		refeField, ok := internal.FieldByFunc(pkFields, internal.EqColumnName(refeColName))
+		if !ok {
+			panic(fmt.Sprintf("goen: reference_key must be the primary key of %s on %s.%s", refeStrct.Name(), strct.Name(), field.Name()))
+		}
+		rel.References = append(rel.References, &RelationalColumn{
+			ColumnName: refeColName,
+			FieldName:  fieldPath(refeField),
+			FieldType:  g.typeString(refeField.Type()),
+		})
+	}
+	for _, fk := range rel.ForeignKeys {
+		foreField, _ := internal.FieldByFunc(strct.Fields(), internal.EqColumnName(fk.ColumnName))
+		fk.FieldType = g.typeString(foreField.Type())
+	}
+}
+
+// typeString gets a type name in the generated code, and imports its package.
+func (g *Generator) typeString(typ internal.Type) string {
+	pkgName, pkgPath := g.safePkgImport(typ)
+	if pkgPath != "" {
+		g.addImportAs(pkgName, pkgPath)
+	}
+	if alter, ok := typ.(internal.TypeAlternator); ok {
+		return alter.StringWithPkgName(pkgName)
+	}
+	return typ.String()
+}
+
+// addEmbeds adds embed fields that col is flattened from.
+func (tbl *Table) addEmbeds(embedFields []internal.StructField, col *Column) {
+	var (
+		parent    *Embed
+		fieldPath string
+	)
+	for _, embedField := range embedFields {
+		if parent == nil {
+			fieldPath = embedField.Name()
+		} else {
+			fieldPath += "." + embedField.Name()
+		}
+		typeName := strings.Replace(fieldPath, ".", "_", -1)
+		var embed *Embed
+		for _, other := range tbl.Embeds {
+			if other.TypeName == typeName {
+				embed = other
+				break
+			}
+		}
+		if embed == nil {
+			embed = &Embed{
+				FieldName: embedField.Name(),
+				TypeName:  typeName,
+				Nested:    parent != nil,
+			}
+			tbl.Embeds = append(tbl.Embeds, embed)
+			if parent != nil {
+				parent.Members = append(parent.Members, &EmbedMember{embed.FieldName, embed.TypeName})
+			}
+		}
+		parent = embed
+	}
+	parent.Members = append(parent.Members, &EmbedMember{col.FieldName, col.TypeName})
+}
+
+// fieldPath gets a selector of field from the entity.
+func fieldPath(field internal.StructField) string {
+	var names []string
+	for _, embedField := range internal.EmbeddedBy(field) {
+		names = append(names, embedField.Name())
+	}
+	return strings.Join(append(names, field.Name()), ".")
+}
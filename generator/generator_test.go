@@ -35,6 +35,11 @@ func TestGenerator(t *testing.T) {
 							ColumnName: "value",
 							FieldName:  "Value",
 							FieldType:  "*time.Time",
+							FieldPath:  "Value",
+							TypeName:   "Value",
+							Nullable:   true,
+							ValueType:  "time.Time",
+							Ordered:    true,
 						},
 					},
 				},
@@ -72,20 +77,355 @@ func TestGenerator(t *testing.T) {
 							ColumnName: "value",
 							FieldName:  "Value",
 							FieldType:  "*time.Time",
+							FieldPath:  "Value",
+							TypeName:   "Value",
+							Nullable:   true,
+							ValueType:  "time.Time",
+							Ordered:    true,
 						},
 						&Column{
 							ColumnName: "value2",
 							FieldName:  "Value2",
 							FieldType:  "github_com_satori_go_uuid.UUID",
+							FieldPath:  "Value2",
+							TypeName:   "Value2",
 						},
 						&Column{
 							ColumnName: "value3",
 							FieldName:  "Value3",
 							FieldType:  "github_com_satori_go_uuid.UUID",
+							FieldPath:  "Value3",
+							TypeName:   "Value3",
 						},
 					},
 				},
 			},
 		}, g.pkgData)
 	})
+	t.Run("handling json field", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "json.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		assert.Equal(t, &Package{
+			PackageName: "testing",
+			// json field types are not imported
+			Imports: requiredImports,
+			Tables: []*Table{
+				&Table{
+					TableName: "record",
+					Entity:    "Record",
+					Columns: []*Column{
+						&Column{
+							ColumnName: "id",
+							IsPK:       true,
+							FieldName:  "ID",
+							FieldType:  "int",
+							FieldPath:  "ID",
+							TypeName:   "ID",
+							SumType:    "int64",
+							Ordered:    true,
+						},
+						&Column{
+							ColumnName: "settings",
+							JSON:       true,
+							FieldName:  "Settings",
+							FieldType:  "map[string]interface{}",
+							FieldPath:  "Settings",
+							TypeName:   "Settings",
+						},
+						&Column{
+							ColumnName: "extra",
+							JSON:       true,
+							FieldName:  "Extra",
+							FieldType:  "*time.Time",
+							FieldPath:  "Extra",
+							TypeName:   "Extra",
+						},
+					},
+				},
+			},
+		}, g.pkgData)
+	})
+	t.Run("rejecting json primary key", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "json_pk.go"
+			},
+		}
+		assert.EqualError(t, g.ParseDir(), "goen: json column must not be a part of primary key on Document.Key")
+	})
+	t.Run("handling embed field", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "embed.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		assert.Equal(t, &Package{
+			PackageName: "testing",
+			Imports:     requiredImports,
+			Tables: []*Table{
+				&Table{
+					TableName: "order",
+					Entity:    "Order",
+					Columns: []*Column{
+						&Column{
+							ColumnName: "id",
+							IsPK:       true,
+							FieldName:  "ID",
+							FieldType:  "int",
+							FieldPath:  "ID",
+							TypeName:   "ID",
+							SumType:    "int64",
+							Ordered:    true,
+						},
+						&Column{
+							ColumnName: "billing_street",
+							FieldName:  "Street",
+							FieldType:  "string",
+							FieldPath:  "Billing.Street",
+							TypeName:   "Billing_Street",
+							Embedded:   true,
+						},
+						&Column{
+							ColumnName: "billing_city",
+							FieldName:  "City",
+							FieldType:  "string",
+							FieldPath:  "Billing.City",
+							TypeName:   "Billing_City",
+							Embedded:   true,
+						},
+						&Column{
+							ColumnName: "billing_geo_lat",
+							FieldName:  "Lat",
+							FieldType:  "float64",
+							FieldPath:  "Billing.Geo.Lat",
+							TypeName:   "Billing_Geo_Lat",
+							Embedded:   true,
+							SumType:    "float64",
+							Ordered:    true,
+						},
+						&Column{
+							ColumnName: "billing_geo_lng",
+							FieldName:  "Lng",
+							FieldType:  "float64",
+							FieldPath:  "Billing.Geo.Lng",
+							TypeName:   "Billing_Geo_Lng",
+							Embedded:   true,
+							SumType:    "float64",
+							Ordered:    true,
+						},
+						&Column{
+							ColumnName: "created_at",
+							FieldName:  "CreatedAt",
+							FieldType:  "int64",
+							FieldPath:  "CreatedAt",
+							TypeName:   "CreatedAt",
+							SumType:    "int64",
+							Ordered:    true,
+						},
+					},
+					Embeds: []*Embed{
+						&Embed{
+							FieldName: "Billing",
+							TypeName:  "Billing",
+							Members: []*EmbedMember{
+								&EmbedMember{"Street", "Billing_Street"},
+								&EmbedMember{"City", "Billing_City"},
+								&EmbedMember{"Geo", "Billing_Geo"},
+							},
+						},
+						&Embed{
+							FieldName: "Geo",
+							TypeName:  "Billing_Geo",
+							Nested:    true,
+							Members: []*EmbedMember{
+								&EmbedMember{"Lat", "Billing_Geo_Lat"},
+								&EmbedMember{"Lng", "Billing_Geo_Lng"},
+							},
+						},
+					},
+				},
+			},
+		}, g.pkgData)
+	})
+	t.Run("handling many-to-many field", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "through.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		if !assert.Len(t, g.pkgData.Tables, 2) {
+			return
+		}
+		assert.Equal(t, []*Relation{
+			&Relation{
+				TableName:   "tag",
+				FieldName:   "Tags",
+				FieldType:   "Tag",
+				ColumnNames: []string{"id", "name"},
+				ForeignKeys: []*RelationalColumn{
+					&RelationalColumn{
+						ColumnName: "post_id",
+						FieldName:  "PostID",
+						FieldType:  "int",
+					},
+				},
+				References: []*RelationalColumn{
+					&RelationalColumn{
+						ColumnName: "id",
+						FieldName:  "ID",
+						FieldType:  "int",
+					},
+				},
+				Through:            "post_tags",
+				ThroughForeignKeys: []string{"post_id"},
+				ThroughReferences:  []string{"tag_id"},
+			},
+		}, g.pkgData.Tables[0].ManyToManyRelations)
+		assert.Nil(t, g.pkgData.Tables[0].OneToManyRelations)
+	})
+	t.Run("handling aggregate field", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "aggregate.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		if !assert.Len(t, g.pkgData.Tables, 2) {
+			return
+		}
+		tbl := g.pkgData.Tables[0]
+		if !assert.Len(t, tbl.OneToManyRelations, 1) {
+			return
+		}
+		// aggregate fields are not columns
+		if assert.Len(t, tbl.Columns, 1) {
+			assert.Equal(t, "blog_id", tbl.Columns[0].ColumnName)
+		}
+		keys := []*RelationalColumn{
+			&RelationalColumn{
+				ColumnName: "blog_id",
+				FieldName:  "BlogID",
+				FieldType:  "int",
+			},
+		}
+		assert.Equal(t, []*Aggregate{
+			&Aggregate{
+				Name:      "CountPosts",
+				Func:      "count",
+				ValueType: "int64",
+				Relation:  tbl.OneToManyRelations[0],
+				Keys:      keys,
+				Fields: []*AggregateField{
+					&AggregateField{
+						FieldName: "NumPosts",
+						FieldType: "int",
+					},
+				},
+			},
+			&Aggregate{
+				Name:       "SumPostsLikes",
+				Func:       "sum",
+				ColumnName: "likes",
+				ValueType:  "int64",
+				Relation:   tbl.OneToManyRelations[0],
+				Keys:       keys,
+				Fields: []*AggregateField{
+					&AggregateField{
+						FieldName: "TotalLikes",
+						FieldType: "int64",
+					},
+				},
+			},
+		}, tbl.Aggregates)
+	})
+	t.Run("handling join", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "join.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		if !assert.Len(t, g.pkgData.Tables, 2) {
+			return
+		}
+		blog, post := g.pkgData.Tables[0], g.pkgData.Tables[1]
+		assert.Nil(t, blog.Joins)
+		if !assert.Len(t, post.ManyToOneRelations, 1) {
+			return
+		}
+		// json column is excluded
+		assert.Equal(t, []*Join{
+			&Join{
+				FieldName: "Blog",
+				Alias:     "blog",
+				Relation:  post.ManyToOneRelations[0],
+				Columns:   blog.Columns[:2],
+			},
+		}, post.Joins)
+	})
+	t.Run("handling join of self-referencing relation", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "join_self.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		if !assert.Len(t, g.pkgData.Tables, 1) {
+			return
+		}
+		category := g.pkgData.Tables[0]
+		if assert.Len(t, category.Joins, 1) {
+			assert.Equal(t, "category", category.TableName)
+			assert.Equal(t, "category_join", category.Joins[0].Alias, "alias is not ambiguous with the table")
+		}
+	})
+	t.Run("handling nullable field", func(t *testing.T) {
+		g := &Generator{
+			SrcDir: "./testdata/",
+			SrcFileFilter: func(info os.FileInfo) bool {
+				return info.Name() == "nullable.go"
+			},
+		}
+		if !assert.NoError(t, g.ParseDir()) {
+			return
+		}
+		if !assert.Len(t, g.pkgData.Tables, 1) || !assert.Len(t, g.pkgData.Tables[0].Columns, 4) {
+			return
+		}
+		cols := g.pkgData.Tables[0].Columns
+		assert.False(t, cols[0].Nullable)
+		assert.Equal(t, "", cols[0].ValueType)
+		assert.True(t, cols[1].Nullable)
+		assert.Equal(t, "int", cols[1].ValueType)
+		assert.True(t, cols[2].Nullable)
+		assert.Equal(t, "string", cols[2].ValueType)
+		assert.True(t, cols[3].Nullable)
+		assert.Equal(t, "time.Time", cols[3].ValueType)
+		assert.Contains(t, g.pkgData.Imports, &Import{Name: "time", Path: "time"}, "value type of sql.NullTime is imported")
+	})
 }
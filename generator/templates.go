@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"fmt"
 	"path"
 	"text/template"
 )
@@ -8,9 +9,27 @@ import (
 var (
 	debug = ""
 
-	templates = template.New("goen")
+	templates = template.New("goen").Funcs(template.FuncMap{
+		"dict": dict,
+	})
 )
 
+// dict makes a map from key value pairs, to pass multiple values to a template.
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("goen: dict requires key value pairs, got %d arguments", len(pairs))
+	}
+	m := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("goen: dict key must be a string, not %T", pairs[i])
+		}
+		m[key] = pairs[i+1]
+	}
+	return m, nil
+}
+
 //go:generate esc -o bindata.go -pkg generator -private templates/
 func init() {
 	// it panics on useLocal is true, since cwd is mismatched
@@ -0,0 +1,21 @@
+// +build testdata
+
+package testing
+
+type Blog struct {
+	BlogID int `goen:"" primary_key:""`
+
+	Posts []*Post `foreign_key:"blog_id"`
+
+	NumPosts int `aggregate:"count,Posts"`
+
+	TotalLikes int64 `aggregate:"sum,Posts,likes"`
+}
+
+type Post struct {
+	PostID int `goen:"" primary_key:""`
+
+	BlogID int
+
+	Likes int64
+}
@@ -0,0 +1,26 @@
+// +build testdata
+
+package testing
+
+type Geo struct {
+	Lat float64
+	Lng float64
+}
+
+type Address struct {
+	Street string
+	City   string
+	Geo    Geo `embed:""`
+}
+
+type Timestamp struct {
+	CreatedAt int64
+}
+
+type Order struct {
+	ID int `goen:"" primary_key:""`
+
+	Billing Address `embed:"billing_"`
+
+	Timestamp
+}
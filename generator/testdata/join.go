@@ -0,0 +1,19 @@
+// +build testdata
+
+package testing
+
+type Blog struct {
+	BlogID int `goen:"" primary_key:""`
+
+	Name string
+
+	Meta map[string]string `column:",json"`
+}
+
+type Post struct {
+	PostID int `goen:"" primary_key:""`
+
+	BlogID int
+
+	Blog *Blog `foreign_key:"blog_id"`
+}
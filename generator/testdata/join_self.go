@@ -0,0 +1,11 @@
+// +build testdata
+
+package testing
+
+type Category struct {
+	CategoryID int `goen:"" primary_key:""`
+
+	ParentID int
+
+	Category *Category `foreign_key:"parent_id:category_id"`
+}
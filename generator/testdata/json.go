@@ -0,0 +1,13 @@
+// +build testdata
+
+package testing
+
+import (
+	"time"
+)
+
+type Record struct {
+	ID       int                    `goen:"" primary_key:""`
+	Settings map[string]interface{} `column:",json"`
+	Extra    *time.Time             `column:",json"`
+}
@@ -0,0 +1,7 @@
+// +build testdata
+
+package testing
+
+type Document struct {
+	Key map[string]interface{} `goen:"" primary_key:",json"`
+}
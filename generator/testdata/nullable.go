@@ -0,0 +1,17 @@
+// +build testdata
+
+package testing
+
+import (
+	"database/sql"
+)
+
+type Record struct {
+	ID int `goen:"" primary_key:""`
+
+	Count *int
+
+	Name sql.NullString
+
+	ExpiredAt sql.NullTime
+}
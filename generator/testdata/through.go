@@ -0,0 +1,15 @@
+// +build testdata
+
+package testing
+
+type Post struct {
+	PostID int `goen:"" primary_key:""`
+
+	Tags []*Tag `through:"post_tags" foreign_key:"post_id" reference_key:"id:tag_id"`
+}
+
+type Tag struct {
+	ID int `goen:"" primary_key:""`
+
+	Name string
+}
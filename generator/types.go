@@ -23,11 +23,19 @@ type Table struct {
 
 	Columns []*Column
 
+	Embeds []*Embed
+
 	OneToManyRelations []*Relation
 
 	ManyToOneRelations []*Relation
 
 	OneToOneRelations []*Relation
+
+	ManyToManyRelations []*Relation
+
+	Aggregates []*Aggregate
+
+	Joins []*Join
 }
 
 type Column struct {
@@ -37,9 +45,52 @@ type Column struct {
 
 	OmitEmpty bool
 
+	// JSON indicates the column value is serialized as JSON, it's not comparable.
+	JSON bool
+
 	FieldName string
 
 	FieldType string
+
+	// FieldPath is a selector from the entity; e.g. Billing.City for an embedded column.
+	FieldPath string
+
+	// TypeName is an unique name in the entity for generated types; e.g. Billing_City.
+	TypeName string
+
+	// Embedded indicates the column is flattened from an embed field.
+	Embedded bool
+
+	// Nullable indicates the column type is a pointer or sql.NullXxx, then Eq(nil) is IS NULL.
+	Nullable bool
+
+	// ValueType is an underlying type of a nullable column, or empty; e.g. time.Time for *time.Time.
+	ValueType string
+
+	// SumType is a result type of SUM() for a numeric column, or empty for others; e.g. int64, float64.
+	SumType string
+
+	// Ordered indicates the column is numeric or time, then MIN() and MAX() are typed by FieldType.
+	Ordered bool
+}
+
+// Embed represents an embed field, its struct fields are flattened into prefixed columns.
+type Embed struct {
+	FieldName string
+
+	TypeName string
+
+	// Nested indicates the field is in another embed field.
+	Nested bool
+
+	// Members are columns or nested embed fields.
+	Members []*EmbedMember
+}
+
+type EmbedMember struct {
+	FieldName string
+
+	TypeName string
 }
 
 // Relation represents another table relation information.
@@ -61,6 +112,15 @@ type Relation struct {
 
 	// another table columns
 	References []*RelationalColumn
+
+	// join table name, for many-to-many relation
+	Through string
+
+	// join table columns referring this table, for many-to-many relation
+	ThroughForeignKeys []string
+
+	// join table columns referring another table, for many-to-many relation
+	ThroughReferences []string
 }
 
 type RelationalColumn struct {
@@ -70,3 +130,46 @@ type RelationalColumn struct {
 
 	FieldType string
 }
+
+// Aggregate represents an aggregated value of a one-to-many relation for each entity.
+type Aggregate struct {
+	// unique name in the entity for generated types; e.g. CountPosts, SumPostsOrder
+	Name string
+
+	// aggregate function name; count, sum, avg, min or max
+	Func string
+
+	// another table column name to be aggregated, it's empty for count
+	ColumnName string
+
+	// aggregated value type
+	ValueType string
+
+	Relation *Relation
+
+	// another table columns grouped by, and this entity's fields referred by them
+	Keys []*RelationalColumn
+
+	// this entity's fields to be filled
+	Fields []*AggregateField
+}
+
+type AggregateField struct {
+	FieldName string
+
+	FieldType string
+}
+
+// Join represents a to-one relation joined by the query builder, another table columns are exposed under the alias.
+type Join struct {
+	// this entity's field name
+	FieldName string
+
+	// table alias in the query; e.g. blog for Blog field
+	Alias string
+
+	Relation *Relation
+
+	// another table columns, json and embedded columns are excluded
+	Columns []*Column
+}
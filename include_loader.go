@@ -4,6 +4,10 @@ import (
 	"container/list"
 	"context"
 	"reflect"
+	"strings"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
 )
 
 type IncludeBuffer list.List
@@ -26,6 +30,29 @@ func (fn IncludeLoaderFunc) Load(ctx context.Context, later *IncludeBuffer, sc *
 	return fn(ctx, later, sc, records)
 }
 
+// IncludeFetcher is an optional interface for IncludeLoader, to be run concurrently.
+// Fetch queries related entities without writing records, and returns a function assigning them to records.
+// DBContext calls the assigning functions on the calling goroutine, in loaders order.
+type IncludeFetcher interface {
+	Fetch(ctx context.Context, later *IncludeBuffer, sc *ScopeCache, records interface{}) (assign func(), err error)
+}
+
+// IncludeFetcherFunc is an IncludeLoader and IncludeFetcher by a function.
+type IncludeFetcherFunc func(context.Context, *IncludeBuffer, *ScopeCache, interface{}) (func(), error)
+
+func (fn IncludeFetcherFunc) Load(ctx context.Context, later *IncludeBuffer, sc *ScopeCache, records interface{}) error {
+	assign, err := fn(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
+}
+
+func (fn IncludeFetcherFunc) Fetch(ctx context.Context, later *IncludeBuffer, sc *ScopeCache, records interface{}) (func(), error) {
+	return fn(ctx, later, sc, records)
+}
+
 type IncludeLoaderList []IncludeLoader
 
 func (list *IncludeLoaderList) Append(v ...IncludeLoader) {
@@ -40,3 +67,37 @@ func (list IncludeLoaderList) Load(ctx context.Context, later *IncludeBuffer, sc
 	}
 	return nil
 }
+
+// SelectPerParent builds a query selecting columns of table filtered by where and ordered by orderBys.
+// Rows are limited to limit rows for each parent identified by parentColumns, zero means unlimited.
+// The limit is done by ROW_NUMBER() window function if the dialect supports it.
+// Otherwise, limited is false and callers must limit loaded rows by themselves.
+// Given table and column names are unquoted, orderBys are expressions.
+func SelectPerParent(d dialect.Dialect, table string, columns []string, where sqr.Sqlizer, orderBys []string, parentColumns []string, limit uint64) (query sqr.SelectBuilder, limited bool) {
+	quote := func(names []string) []string {
+		quoted := make([]string, len(names))
+		for i := range names {
+			quoted[i] = d.Quote(names[i])
+		}
+		return quoted
+	}
+	stmtBuilder := sqr.StatementBuilder.PlaceholderFormat(d.PlaceholderFormat())
+	capa, ok := d.(dialect.Capabilities)
+	if limit == 0 || !ok || !capa.SupportsWindowFunctions() {
+		query = stmtBuilder.Select(quote(columns)...).From(d.Quote(table)).Where(where).OrderBy(orderBys...)
+		return query, limit == 0
+	}
+
+	rowNumber := "ROW_NUMBER() OVER (PARTITION BY " + strings.Join(quote(parentColumns), ", ")
+	if len(orderBys) > 0 {
+		rowNumber += " ORDER BY " + strings.Join(orderBys, ", ")
+	}
+	rowNumber += ") AS " + d.Quote("goen_row_number")
+	// placeholders are replaced by the outer query
+	partitioned := sqr.Select(quote(columns)...).Column(rowNumber).From(d.Quote(table)).Where(where)
+	query = stmtBuilder.Select(quote(columns)...).
+		FromSelect(partitioned, "goen_partitioned").
+		Where(d.Quote("goen_row_number")+" <= ?", limit).
+		OrderBy(d.Quote("goen_row_number"))
+	return query, true
+}
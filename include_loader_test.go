@@ -5,9 +5,36 @@ import (
 	"context"
 	"testing"
 
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
 	"github.com/stretchr/testify/assert"
 )
 
+type testingCapabilitiesDialect struct {
+	// other capabilities are not implemented
+	dialect.Capabilities
+
+	windowFunctions bool
+
+	rowValues bool
+}
+
+func (*testingCapabilitiesDialect) PlaceholderFormat() sqr.PlaceholderFormat {
+	return sqr.Dollar
+}
+
+func (*testingCapabilitiesDialect) Quote(s string) string {
+	return `"` + s + `"`
+}
+
+func (d *testingCapabilitiesDialect) SupportsWindowFunctions() bool {
+	return d.windowFunctions
+}
+
+func (d *testingCapabilitiesDialect) SupportsRowValues() bool {
+	return d.rowValues
+}
+
 func TestIncludeBuffer(t *testing.T) {
 	t.Run("AddRecords", func(t *testing.T) {
 		l := list.New()
@@ -44,3 +71,51 @@ func TestIncludeLoaderFunc(t *testing.T) {
 func TestIncludeLoaderList(t *testing.T) {
 	assert.Implements(t, (*IncludeLoader)(nil), IncludeLoaderList(nil))
 }
+
+func TestSelectPerParent(t *testing.T) {
+	cases := []struct {
+		Dialect dialect.Dialect
+		Limit   uint64
+		Query   string
+		Args    []interface{}
+		Limited bool
+	}{
+		{
+			&testingDialect{},
+			0,
+			`SELECT "id", "parent_id" FROM "children" WHERE "title" <> ? ORDER BY "id" DESC`,
+			[]interface{}{"draft"},
+			true,
+		},
+		{
+			&testingDialect{},
+			2,
+			`SELECT "id", "parent_id" FROM "children" WHERE "title" <> ? ORDER BY "id" DESC`,
+			[]interface{}{"draft"},
+			false,
+		},
+		{
+			&testingCapabilitiesDialect{windowFunctions: false},
+			2,
+			`SELECT "id", "parent_id" FROM "children" WHERE "title" <> $1 ORDER BY "id" DESC`,
+			[]interface{}{"draft"},
+			false,
+		},
+		{
+			&testingCapabilitiesDialect{windowFunctions: true},
+			2,
+			`SELECT "id", "parent_id" FROM (SELECT "id", "parent_id", ROW_NUMBER() OVER (PARTITION BY "parent_id" ORDER BY "id" DESC) AS "goen_row_number" FROM "children" WHERE "title" <> $1) AS goen_partitioned WHERE "goen_row_number" <= $2 ORDER BY "goen_row_number"`,
+			[]interface{}{"draft", uint64(2)},
+			true,
+		},
+	}
+	for _, c := range cases {
+		builder, limited := SelectPerParent(c.Dialect, "children", []string{"id", "parent_id"}, sqr.NotEq{`"title"`: "draft"}, []string{`"id" DESC`}, []string{"parent_id"}, c.Limit)
+		query, args, err := builder.ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.Query, query)
+			assert.Equal(t, c.Args, args)
+		}
+		assert.Equal(t, c.Limited, limited)
+	}
+}
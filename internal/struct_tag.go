@@ -6,13 +6,17 @@ import (
 )
 
 const (
-	TagGoen       = "goen"
-	TagTable      = "table"
-	TagView       = "view"
-	TagPrimaryKey = "primary_key"
-	TagColumn     = "column"
-	TagForeignKey = "foreign_key"
-	TagIgnore     = "ignore"
+	TagGoen         = "goen"
+	TagTable        = "table"
+	TagView         = "view"
+	TagPrimaryKey   = "primary_key"
+	TagColumn       = "column"
+	TagForeignKey   = "foreign_key"
+	TagIgnore       = "ignore"
+	TagEmbed        = "embed"
+	TagThrough      = "through"
+	TagReferenceKey = "reference_key"
+	TagAggregate    = "aggregate"
 )
 
 type TableSpec string
@@ -41,6 +45,8 @@ func (s TableSpec) ReadOnly() bool {
 //   `column:"col,omitempty"`
 //   `column:",omitempty"`
 //   `column:","`
+//   `column:"col,json"`
+//   `column:"col,json,omitempty"`
 type ColumnSpec string
 
 func (s ColumnSpec) Name() string {
@@ -52,11 +58,26 @@ func (s ColumnSpec) Name() string {
 }
 
 func (s ColumnSpec) OmitEmpty() bool {
+	return s.hasOption("omitempty")
+}
+
+// JSON reports whether the column value is serialized as JSON.
+func (s ColumnSpec) JSON() bool {
+	return s.hasOption("json")
+}
+
+func (s ColumnSpec) hasOption(name string) bool {
 	tv, ok := FirstLookup(reflect.StructTag(s), TagPrimaryKey, TagColumn)
 	if !ok {
 		return false
 	}
-	return stringAt(tv, ",", 1) == "omitempty"
+	opts := strings.Split(tv, ",")
+	for _, opt := range opts[1:] {
+		if opt == name {
+			return true
+		}
+	}
+	return false
 }
 
 // struct tag example:
@@ -87,7 +108,64 @@ func (s ForeignKeySpec) ChildKey() []string {
 }
 
 func (s ForeignKeySpec) colpairs() [][]string {
-	tv, ok := reflect.StructTag(s).Lookup(TagForeignKey)
+	return colpairs(reflect.StructTag(s), TagForeignKey)
+}
+
+// struct tag example:
+//   `through:"post_tags" foreign_key:"post_id" reference_key:"tag_id"`
+//     => post(post_id) - post_tags(post_id, tag_id) - tag(tag_id)
+//   `through:"post_tags" foreign_key:"id:post_id" reference_key:"id:tag_id"`
+//     => post(id) - post_tags(post_id, tag_id) - tag(id)
+type ReferenceKeySpec string
+
+// ParentKey is the column or set of columns in the referenced table through the join table.
+func (s ReferenceKeySpec) ParentKey() []string {
+	pairs := colpairs(reflect.StructTag(s), TagReferenceKey)
+	key := make([]string, len(pairs))
+	for i, pair := range pairs {
+		key[i] = pair[0]
+	}
+	return key
+}
+
+// ChildKey is the column or set of columns in the join table that refer to the referenced table.
+func (s ReferenceKeySpec) ChildKey() []string {
+	pairs := colpairs(reflect.StructTag(s), TagReferenceKey)
+	key := make([]string, len(pairs))
+	for i, pair := range pairs {
+		key[i] = pair[1]
+	}
+	return key
+}
+
+// struct tag example:
+//   `aggregate:"count,Posts"`
+//     => count(*) of Posts for each entity
+//   `aggregate:"sum,Posts,order"`
+//     => sum(order) of Posts for each entity
+type AggregateSpec string
+
+// Func is an aggregate function name in lower case.
+func (s AggregateSpec) Func() string {
+	return strings.ToLower(strings.TrimSpace(stringAt(s.value(), ",", 0)))
+}
+
+// Relation is a field name of the one-to-many relation to be aggregated.
+func (s AggregateSpec) Relation() string {
+	return strings.TrimSpace(stringAt(s.value(), ",", 1))
+}
+
+// ColumnName is a column name of the related table to be aggregated, it's empty for count.
+func (s AggregateSpec) ColumnName() string {
+	return strings.TrimSpace(stringAt(s.value(), ",", 2))
+}
+
+func (s AggregateSpec) value() string {
+	return reflect.StructTag(s).Get(TagAggregate)
+}
+
+func colpairs(tag reflect.StructTag, name string) [][]string {
+	tv, ok := tag.Lookup(name)
 	if !ok {
 		return nil
 	}
@@ -77,7 +77,7 @@ func IsViewStruct(strct Struct) bool {
 }
 
 func IsColumnField(field StructField) bool {
-	return !IsIgnoredField(field) && !IsForeignKeyField(field)
+	return !IsIgnoredField(field) && !IsForeignKeyField(field) && !IsAggregateField(field)
 }
 
 func ColumnName(field StructField) string {
@@ -89,7 +89,35 @@ func ColumnName(field StructField) string {
 		spec := ColumnSpec(field.Tag())
 		name = FirstNotEmpty(spec.Name(), name)
 	}
-	return name
+	var prefix string
+	for _, embedField := range EmbeddedBy(field) {
+		prefix += EmbedPrefix(embedField)
+	}
+	return prefix + name
+}
+
+// EmbedPrefix gets a column name prefix of the embed field.
+// The prefix defaults to snake cased field name with "_".
+func EmbedPrefix(field StructField) string {
+	if !IsEmbedField(field) {
+		panic("goen: unable to get embed prefix from non-embed field")
+	}
+	prefix := field.Tag().Get(TagEmbed)
+	return FirstNotEmpty(prefix, strcase.SnakeCase(field.Name())+"_")
+}
+
+// EmbeddedBy gets embed fields that field is flattened from, outermost first.
+// It returns nil when field is not flattened from embed field.
+func EmbeddedBy(field StructField) []StructField {
+	var path []StructField
+	for {
+		ef, ok := field.(*embeddedField)
+		if !ok {
+			return path
+		}
+		path = append(path, ef.embeddedBy)
+		field = ef.StructField
+	}
 }
 
 func OmitEmpty(field StructField) bool {
@@ -104,6 +132,16 @@ func OmitEmpty(field StructField) bool {
 	return omitEmpty
 }
 
+func IsJSONField(field StructField) bool {
+	if IsIgnoredField(field) {
+		panic("goen: unable to get json from ignored field")
+	}
+	if _, ok := FirstLookup(field.Tag(), TagPrimaryKey, TagColumn); ok {
+		return ColumnSpec(field.Tag()).JSON()
+	}
+	return false
+}
+
 func ForeignKey(field StructField) []string {
 	if !IsForeignKeyField(field) {
 		panic("goen: unable to get foreign key from non-foreign key field")
@@ -120,6 +158,31 @@ func ReferenceKey(field StructField) []string {
 	return spec.ChildKey()
 }
 
+func ThroughTable(field StructField) string {
+	if !IsManyToManyField(field) {
+		panic("goen: unable to get through table from non-many-to-many field")
+	}
+	return field.Tag().Get(TagThrough)
+}
+
+// TargetKey gets columns of the table which is referenced through the join table.
+func TargetKey(field StructField) []string {
+	if !IsManyToManyField(field) {
+		panic("goen: unable to get target key from non-many-to-many field")
+	}
+	spec := ReferenceKeySpec(field.Tag())
+	return spec.ParentKey()
+}
+
+// ThroughTargetKey gets columns of the join table which refer to the target table.
+func ThroughTargetKey(field StructField) []string {
+	if !IsManyToManyField(field) {
+		panic("goen: unable to get through target key from non-many-to-many field")
+	}
+	spec := ReferenceKeySpec(field.Tag())
+	return spec.ChildKey()
+}
+
 func EqFieldName(name string) func(StructField) bool {
 	return func(field StructField) bool {
 		return field.Name() == name
@@ -158,6 +221,17 @@ func IsIgnoredField(field StructField) bool {
 	return ok
 }
 
+func IsEmbedField(field StructField) bool {
+	_, ok := field.Tag().Lookup(TagEmbed)
+	return ok
+}
+
+// IsAggregateField reports whether field holds an aggregated value of a relation, it's not a column.
+func IsAggregateField(field StructField) bool {
+	_, ok := field.Tag().Lookup(TagAggregate)
+	return ok
+}
+
 func IsPrimaryKeyField(field StructField) bool {
 	_, ok := field.Tag().Lookup(TagPrimaryKey)
 	return ok
@@ -168,6 +242,13 @@ func IsForeignKeyField(field StructField) bool {
 	return ok
 }
 
+func IsManyToManyField(field StructField) bool {
+	if _, ok := field.Tag().Lookup(TagThrough); !ok {
+		return false
+	}
+	return IsOneToManyField(field)
+}
+
 func IsOneToManyField(field StructField) bool {
 	typ := field.Type()
 	if typ.Kind() != reflect.Slice {
@@ -179,6 +260,33 @@ func IsOneToManyField(field StructField) bool {
 	return mustValidKind(typ.Kind()) == reflect.Struct
 }
 
+// IsOneToOneField reports whether field of strct refers another entity which has a foreign key to strct.
+// It's when the foreign key covers the primary key of strct.
+// Check it before IsManyToOneField, since the field is also a many-to-one field shape.
+func IsOneToOneField(strct Struct, field StructField) bool {
+	if !IsForeignKeyField(field) || !IsManyToOneField(field) {
+		return false
+	}
+	pkFields := FieldsByFunc(FieldsByFunc(strct.Fields(), IsColumnField), IsPrimaryKeyField)
+	if len(pkFields) == 0 {
+		return false
+	}
+	foreKey := ForeignKey(field)
+	for _, pkField := range pkFields {
+		found := false
+		for _, colName := range foreKey {
+			if colName == ColumnName(pkField) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func IsManyToOneField(field StructField) bool {
 	typ := field.Type()
 	for typ.Kind() == reflect.Ptr {
@@ -187,6 +295,23 @@ func IsManyToOneField(field StructField) bool {
 	return mustValidKind(typ.Kind()) == reflect.Struct
 }
 
+// embeddedField is a field of the struct held by embed field.
+type embeddedField struct {
+	StructField
+
+	embeddedBy StructField
+}
+
+// embedFields flattens fields of the embed field's struct.
+func embedFields(field StructField, strct Struct) []StructField {
+	inner := strct.Fields()
+	fields := make([]StructField, len(inner))
+	for i := range inner {
+		fields[i] = &embeddedField{inner[i], field}
+	}
+	return fields
+}
+
 func mustValidKind(v reflect.Kind) reflect.Kind {
 	if v == reflect.Invalid {
 		panic("goen: invalid kind of type")
@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"reflect"
 	"strings"
 
@@ -61,7 +62,15 @@ func (astrct *aStruct) Fields() (fields []StructField) {
 				if !ast.IsExported(name.Name) {
 					continue
 				}
-				fields = append(fields, &aStructField{astrct, field, name})
+				afield := &aStructField{astrct, field, name}
+				if IsEmbedField(afield) {
+					if kind := afield.Type().Kind(); kind != reflect.Struct {
+						panic(fmt.Sprintf("goen: embed field %s must be a struct, but got %s", name.Name, kind))
+					}
+					fields = append(fields, embedFields(afield, afield.Type().NewStruct())...)
+				} else {
+					fields = append(fields, afield)
+				}
 			}
 		}
 	}
@@ -212,6 +221,10 @@ func (at *aType) Kind() reflect.Kind {
 			kind = typ.Kind()
 		case *ast.StructType:
 			kind = reflect.Struct
+		case *ast.MapType:
+			kind = reflect.Map
+		case *ast.InterfaceType:
+			kind = reflect.Interface
 		default:
 			panic(fmt.Sprintf("goen: unknown expr type %T", expr))
 		}
@@ -232,6 +245,8 @@ func (at *aType) Elem() Type {
 			elem = newAType(at.pkg, at.file, expr.X)
 		case *ast.ArrayType:
 			elem = newAType(at.pkg, at.file, expr.Elt)
+		case *ast.MapType:
+			elem = newAType(at.pkg, at.file, expr.Value)
 		}
 		return nil
 	})
@@ -254,9 +269,14 @@ func (at *aType) NewStruct() Struct {
 	} else {
 		pkg = at.pkg
 	}
-	file, obj, ok := asts.ObjectByFunc(at.pkg, asts.EqObjectName(at.Name()))
+	// e.g. time.Time => Time
+	typName := at.Name()
+	if i := strings.LastIndex(typName, "."); i >= 0 {
+		typName = typName[i+1:]
+	}
+	file, obj, ok := asts.ObjectByFunc(pkg, asts.EqObjectName(typName))
 	if !ok {
-		panic(fmt.Sprintf("goen: couldnot find a struct %q in package %q", at.Name(), pkg.Name))
+		panic(fmt.Sprintf("goen: couldnot find a struct %q in package %q", typName, pkg.Name))
 	}
 	return NewStructFromAST(pkg, file, obj)
 }
@@ -291,6 +311,16 @@ func (at *aType) StringWithPkgName(altPkgName string) string {
 			typName := expr.Sel.Name
 			s += pkgName + "." + typName
 			return nil
+		case *ast.MapType:
+			// e.g. x map[string]time.Time
+			key := newAType(at.pkg, at.file, expr.Key)
+			value := newAType(at.pkg, at.file, expr.Value)
+			s += "map[" + key.StringWithPkgName(altPkgName) + "]" + value.StringWithPkgName(altPkgName)
+			return nil
+		case *ast.InterfaceType:
+			// e.g. x interface{}
+			s += types.ExprString(expr)
+			return nil
 		default:
 			return nil
 		}
@@ -120,6 +120,8 @@ func TestAType(t *testing.T) {
 			{reflect.Int, "IntDecl"},
 			{reflect.Ptr, "StringPtrDecl"},
 			{reflect.Slice, "StringSliceDecl"},
+			{reflect.Map, "MapDecl"},
+			{reflect.Interface, "InterfaceDecl"},
 		}
 		strct := NewStructFromAST(astTestData("DeclTypes"))
 		for _, c := range cases {
@@ -165,6 +167,8 @@ func TestAType(t *testing.T) {
 			{"[]time.Time", "TimeSliceDecl"},
 			{"[]*time.Time", "TimePtrSliceDecl"},
 			{"*[]time.Time", "TimeSlicePtrDecl"},
+			{"map[string]*time.Time", "MapDecl"},
+			{"interface{}", "InterfaceDecl"},
 		}
 		strct := NewStructFromAST(astTestData("DeclTypes"))
 		for _, c := range cases {
@@ -7,18 +7,24 @@ import (
 
 type rStruct struct {
 	reflect.Type
+
+	// index sequence from the root struct, for embedded struct
+	index []int
 }
 
 func NewStructFromReflect(typ reflect.Type) Struct {
 	if typ.Kind() != reflect.Struct {
 		panic(fmt.Sprintf("goen: only accepts struct type, but got %q", typ))
 	}
-	return &rStruct{typ}
+	return &rStruct{Type: typ}
 }
 
+// Fields gets fields of the struct.
+// The index of each field is a sequence from the root struct, to be used with reflect.Value.FieldByIndex.
 func (rstrct *rStruct) Fields() (fields []StructField) {
 	for i := 0; i < rstrct.Type.NumField(); i++ {
 		f := rstrct.Type.Field(i)
+		f.Index = append(append([]int{}, rstrct.index...), f.Index...)
 		if f.Anonymous {
 			embeddedTyp := f.Type
 			for embeddedTyp.Kind() == reflect.Ptr {
@@ -26,11 +32,16 @@ func (rstrct *rStruct) Fields() (fields []StructField) {
 			}
 			// only support embedded struct
 			if embeddedTyp.Kind() == reflect.Struct {
-				embedded := NewStructFromReflect(embeddedTyp)
+				embedded := &rStruct{embeddedTyp, f.Index}
 				fields = append(fields, embedded.Fields()...)
 			}
+		} else if field := (&rStructField{f}); IsEmbedField(field) {
+			if f.Type.Kind() != reflect.Struct {
+				panic(fmt.Sprintf("goen: embed field %s must be a struct, but got %q", f.Name, f.Type))
+			}
+			fields = append(fields, embedFields(field, &rStruct{f.Type, f.Index})...)
 		} else {
-			fields = append(fields, &rStructField{f})
+			fields = append(fields, field)
 		}
 	}
 	return fields
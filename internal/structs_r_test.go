@@ -55,6 +55,37 @@ func TestRStruct(t *testing.T) {
 			}
 			assert.Equal(t, []string{"AnkoSuki", "AnkoSokosoko", "AnkoKirai", "AnkoNanisore"}, names)
 		})
+		t.Run("", func(t *testing.T) {
+			type TestingEmbed struct {
+				AnkoNanisore string
+
+				Testing Testing `embed:"testing_"`
+			}
+
+			strct := NewStructFromReflect(reflect.TypeOf(TestingEmbed{}))
+			fields := strct.Fields()
+			var (
+				names   []string
+				indices [][]int
+			)
+			for _, field := range fields {
+				names = append(names, field.Name())
+				indices = append(indices, field.Value().(reflect.StructField).Index)
+			}
+			assert.Equal(t, []string{"AnkoNanisore", "AnkoSuki", "AnkoSokosoko", "AnkoKirai"}, names)
+			assert.Equal(t, [][]int{{0}, {1, 0}, {1, 1}, {1, 2}}, indices)
+			assert.Equal(t, "testing_anko_suki", ColumnName(fields[1]))
+		})
+		t.Run("", func(t *testing.T) {
+			type TestingEmbed struct {
+				Testing *Testing `embed:""`
+			}
+
+			strct := NewStructFromReflect(reflect.TypeOf(TestingEmbed{}))
+			assert.Panics(t, func() {
+				strct.Fields()
+			}, "panics when embed field is not a struct")
+		})
 	})
 	t.Run("Value", func(t *testing.T) {
 		typ := reflect.TypeOf(Testing{})
@@ -77,6 +77,7 @@ func TestIsColumnField(t *testing.T) {
 		{false, &testingStructField{tag: `ignore:"" primary_key:""`}},
 		{false, &testingStructField{tag: `ignore:"" column:""`}},
 		{false, &testingStructField{tag: `ignore:"" foreign_key:""`}},
+		{false, &testingStructField{tag: `aggregate:"count,Posts"`}},
 	}
 	for _, c := range cases {
 		assert.Equal(t, c.Expect, IsColumnField(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
@@ -92,6 +93,21 @@ func TestColumnName(t *testing.T) {
 		{"anko_kirai", &testingStructField{name: "AnkoSuki", tag: `primary_key:"anko_kirai"`}},
 		{"anko_kirai", &testingStructField{name: "AnkoSuki", tag: `column:"anko_kirai"`}},
 		{"anko_suki", &testingStructField{name: "AnkoSuki", tag: `column:",omitempty"`}},
+		{"billing_anko_suki", &embeddedField{
+			&testingStructField{name: "AnkoSuki", tag: ``},
+			&testingStructField{name: "Billing", tag: `embed:"billing_"`},
+		}},
+		{"billing_anko_kirai", &embeddedField{
+			&testingStructField{name: "AnkoSuki", tag: `column:"anko_kirai"`},
+			&testingStructField{name: "Billing", tag: `embed:""`},
+		}},
+		{"a_b_anko_suki", &embeddedField{
+			&embeddedField{
+				&testingStructField{name: "AnkoSuki", tag: ``},
+				&testingStructField{name: "B", tag: `embed:"b_"`},
+			},
+			&testingStructField{name: "A", tag: `embed:"a_"`},
+		}},
 	}
 	for _, c := range cases {
 		assert.Equal(t, c.ColumnName, ColumnName(c.Field), "StructField(name=%q tag=`%s`)", c.Field.Name(), c.Field.Tag())
@@ -106,12 +122,31 @@ func TestOmitEmpty(t *testing.T) {
 		{false, &testingStructField{tag: ``}},
 		{true, &testingStructField{tag: `primary_key:"anko_kirai,omitempty"`}},
 		{true, &testingStructField{tag: `column:"anko_kirai,omitempty"`}},
+		{true, &testingStructField{tag: `column:"anko_kirai,json,omitempty"`}},
+		{false, &testingStructField{tag: `column:"anko_kirai,json"`}},
 	}
 	for _, c := range cases {
 		assert.Equal(t, c.OmitEmpty, OmitEmpty(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
 	}
 }
 
+func TestIsJSONField(t *testing.T) {
+	cases := []struct {
+		JSON  bool
+		Field StructField
+	}{
+		{false, &testingStructField{tag: ``}},
+		{false, &testingStructField{tag: `column:"json"`}},
+		{true, &testingStructField{tag: `column:"settings,json"`}},
+		{true, &testingStructField{tag: `column:",omitempty,json"`}},
+		// parsed as is, then rejected by the generator and MetaSchema
+		{true, &testingStructField{tag: `primary_key:",json"`}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.JSON, IsJSONField(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+	}
+}
+
 func TestForeignKey(t *testing.T) {
 	cases := []struct {
 		ForeignKey []string
@@ -138,6 +173,67 @@ func TestReferenceKey(t *testing.T) {
 	}
 }
 
+func TestThroughKey(t *testing.T) {
+	type Tag struct{}
+	typ := reflect.TypeOf([]*Tag{})
+	cases := []struct {
+		TargetKey        []string
+		ThroughTargetKey []string
+		Field            StructField
+	}{
+		{[]string{"a", "b"}, []string{"a", "b"}, &testingStructField{typ: typ, tag: `through:"x" reference_key:"a,b"`}},
+		{[]string{"a", "b"}, []string{"A", "B"}, &testingStructField{typ: typ, tag: `through:"x" reference_key:"a:A,b:B"`}},
+	}
+	for _, c := range cases {
+		assert.True(t, IsManyToManyField(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+		assert.Equal(t, "x", ThroughTable(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+		assert.Equal(t, c.TargetKey, TargetKey(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+		assert.Equal(t, c.ThroughTargetKey, ThroughTargetKey(c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+	}
+	assert.False(t, IsManyToManyField(&testingStructField{typ: typ, tag: `foreign_key:"a"`}))
+	assert.False(t, IsManyToManyField(&testingStructField{typ: reflect.TypeOf(&Tag{}), tag: `through:"x"`}))
+}
+
+func TestAggregateSpec(t *testing.T) {
+	cases := []struct {
+		Func       string
+		Relation   string
+		ColumnName string
+		Spec       AggregateSpec
+	}{
+		{"count", "Posts", "", AggregateSpec(`aggregate:"count,Posts"`)},
+		{"sum", "Posts", "order", AggregateSpec(`aggregate:"SUM, Posts, order"`)},
+		{"", "", "", AggregateSpec(``)},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.Func, c.Spec.Func(), "AggregateSpec(`%s`)", c.Spec)
+		assert.Equal(t, c.Relation, c.Spec.Relation(), "AggregateSpec(`%s`)", c.Spec)
+		assert.Equal(t, c.ColumnName, c.Spec.ColumnName(), "AggregateSpec(`%s`)", c.Spec)
+	}
+}
+
+func TestIsOneToOneField(t *testing.T) {
+	type Profile struct{}
+	strct := &testingStruct{fields: []StructField{
+		&testingStructField{name: "UserID", typ: reflect.TypeOf(0), tag: `primary_key:""`},
+		&testingStructField{name: "ProfileID", typ: reflect.TypeOf(0), tag: ``},
+	}}
+	cases := []struct {
+		Expect bool
+		Field  StructField
+	}{
+		{true, &testingStructField{typ: reflect.TypeOf(&Profile{}), tag: `foreign_key:"user_id"`}},
+		{true, &testingStructField{typ: reflect.TypeOf(&Profile{}), tag: `foreign_key:"user_id:owner_id"`}},
+		{false, &testingStructField{typ: reflect.TypeOf(&Profile{}), tag: `foreign_key:"profile_id"`}},
+		{false, &testingStructField{typ: reflect.TypeOf([]*Profile{}), tag: `foreign_key:"user_id"`}},
+		{false, &testingStructField{typ: reflect.TypeOf(&Profile{}), tag: ``}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.Expect, IsOneToOneField(strct, c.Field), "StructField(tag=`%s`)", c.Field.Tag())
+	}
+	assert.False(t, IsOneToOneField(&testingStruct{}, cases[0].Field), "no primary key")
+}
+
 func TestEqFieldName(t *testing.T) {
 	var ankoKirai StructField = &testingStructField{name: "AnkoKirai"}
 	var ankoSuki StructField = &testingStructField{name: "AnkoSuki"}
@@ -19,6 +19,8 @@ type DeclTypes struct {
 	TimeSliceDecl      []time.Time
 	TimePtrSliceDecl   []*time.Time
 	TimeSlicePtrDecl   *[]time.Time
+	MapDecl            map[string]*time.Time
+	InterfaceDecl      interface{}
 }
 
 type DeclPkgPaths struct {
@@ -0,0 +1,81 @@
+package goen
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
+)
+
+// JSONPathExpr represents a value at a path in a JSON column.
+type JSONPathExpr struct {
+	dialect dialect.Dialect
+
+	column string
+
+	path []string
+}
+
+// JSONPath makes an expression for a value at path in column.
+// column is quoted, and path is keys separated by "."; e.g. "a.b".
+func JSONPath(d dialect.Dialect, column string, path string) *JSONPathExpr {
+	return &JSONPathExpr{
+		dialect: d,
+		column:  column,
+		path:    strings.Split(path, "."),
+	}
+}
+
+// Eq makes a condition whether the value equals to v.
+func (e *JSONPathExpr) Eq(v interface{}) sqr.Sqlizer {
+	return e.compare("=", v)
+}
+
+// NotEq makes a condition whether the value not equals to v.
+func (e *JSONPathExpr) NotEq(v interface{}) sqr.Sqlizer {
+	return e.compare("<>", v)
+}
+
+func (e *JSONPathExpr) compare(op string, v interface{}) sqr.Sqlizer {
+	return &jsonSqlizer{e.dialect, func(jsonOp dialect.JSONOperator) (string, []interface{}, error) {
+		expr, args, err := jsonOp.JSONExtractText(e.column, e.path)
+		if err != nil {
+			return "", nil, err
+		}
+		return expr + " " + op + " ?", append(args, v), nil
+	}}
+}
+
+// JSONHasKey makes a condition whether column has key at the top level.
+func JSONHasKey(d dialect.Dialect, column string, key string) sqr.Sqlizer {
+	return &jsonSqlizer{d, func(jsonOp dialect.JSONOperator) (string, []interface{}, error) {
+		return jsonOp.JSONHasKey(column, key)
+	}}
+}
+
+// JSONContains makes a condition whether column contains v.
+func JSONContains(d dialect.Dialect, column string, v interface{}) sqr.Sqlizer {
+	return &jsonSqlizer{d, func(jsonOp dialect.JSONOperator) (string, []interface{}, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", nil, err
+		}
+		return jsonOp.JSONContains(column, string(b))
+	}}
+}
+
+type jsonSqlizer struct {
+	dialect dialect.Dialect
+
+	fn func(dialect.JSONOperator) (string, []interface{}, error)
+}
+
+func (s *jsonSqlizer) ToSql() (string, []interface{}, error) {
+	jsonOp, ok := s.dialect.(dialect.JSONOperator)
+	if !ok {
+		return "", nil, errors.New("goen: dialect does not support json operators")
+	}
+	return s.fn(jsonOp)
+}
@@ -0,0 +1,51 @@
+package goen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testingJSONDialect struct {
+	testingDialect
+}
+
+func (*testingJSONDialect) JSONExtractText(column string, path []string) (string, []interface{}, error) {
+	return "extract(" + column + ", ?)", []interface{}{path}, nil
+}
+
+func (*testingJSONDialect) JSONHasKey(column string, key string) (string, []interface{}, error) {
+	return "has_key(" + column + ", ?)", []interface{}{key}, nil
+}
+
+func (*testingJSONDialect) JSONContains(column string, value string) (string, []interface{}, error) {
+	return "contains(" + column + ", ?)", []interface{}{value}, nil
+}
+
+func TestJSON(t *testing.T) {
+	d := &testingJSONDialect{}
+	cases := []struct {
+		Sqlizer interface {
+			ToSql() (string, []interface{}, error)
+		}
+		Query string
+		Args  []interface{}
+	}{
+		{JSONPath(d, `"col"`, "a.b").Eq(1), `extract("col", ?) = ?`, []interface{}{[]string{"a", "b"}, 1}},
+		{JSONPath(d, `"col"`, "a").NotEq("x"), `extract("col", ?) <> ?`, []interface{}{[]string{"a"}, "x"}},
+		{JSONHasKey(d, `"col"`, "a"), `has_key("col", ?)`, []interface{}{"a"}},
+		{JSONContains(d, `"col"`, map[string]int{"a": 1}), `contains("col", ?)`, []interface{}{`{"a":1}`}},
+	}
+	for _, c := range cases {
+		query, args, err := c.Sqlizer.ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.Query, query)
+			assert.Equal(t, c.Args, args)
+		}
+	}
+
+	t.Run("unsupported dialect", func(t *testing.T) {
+		_, _, err := JSONHasKey(&testingDialect{}, `"col"`, "a").ToSql()
+		assert.EqualError(t, err, "goen: dialect does not support json operators")
+	})
+}
@@ -0,0 +1,74 @@
+package goen
+
+import (
+	"errors"
+	"fmt"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
+)
+
+// RowLock represents a row-locking clause of a select query, the zero value doesn't lock rows.
+type RowLock struct {
+	locked bool
+
+	mode dialect.LockMode
+
+	wait dialect.LockWait
+}
+
+// ForUpdate locks selected rows for update.
+func (l RowLock) ForUpdate() RowLock {
+	l.locked = true
+	l.mode = dialect.LockForUpdate
+	return l
+}
+
+// ForShare locks selected rows for share.
+func (l RowLock) ForShare() RowLock {
+	l.locked = true
+	l.mode = dialect.LockForShare
+	return l
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (l RowLock) SkipLocked() RowLock {
+	l.locked = true
+	l.wait = dialect.LockSkipLocked
+	return l
+}
+
+// NoWait reports an error without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (l RowLock) NoWait() RowLock {
+	l.locked = true
+	l.wait = dialect.LockNoWait
+	return l
+}
+
+// Apply appends the row-locking clause rendered by the dialect of dbc to stmt, it locks only rows of tables if given.
+// It's only allowed when dbc holds a transaction, and returns an error if the dialect doesn't support the clause; e.g. sqlite3.
+func (l RowLock) Apply(dbc *DBContext, stmt sqr.SelectBuilder, tables ...string) (sqr.SelectBuilder, error) {
+	if !l.locked {
+		return stmt, nil
+	}
+	if dbc.Tx == nil {
+		return stmt, errors.New("goen: row-locking requires a transaction, use DBContext.UseTx")
+	}
+	capa, ok := dbc.Dialect().(dialect.Capabilities)
+	if !ok {
+		return stmt, errors.New("goen: dialect does not support row-locking clauses")
+	}
+	clause, err := capa.LockClause(l.mode, l.wait, tables...)
+	if err != nil {
+		return stmt, err
+	}
+	return stmt.Suffix(clause), nil
+}
+
+// Reject returns an error if rows are locked, for queries which can't lock rows; e.g. aggregates.
+func (l RowLock) Reject(op string) error {
+	if !l.locked {
+		return nil
+	}
+	return fmt.Errorf("goen: row-locking is not supported by %s", op)
+}
@@ -0,0 +1,81 @@
+package goen
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+type testingLockDialect struct {
+	testingCapabilitiesDialect
+}
+
+func (*testingLockDialect) LockClause(mode dialect.LockMode, wait dialect.LockWait, tables ...string) (string, error) {
+	if mode == dialect.LockForShare && wait == dialect.LockSkipLocked {
+		return "", errors.New("not supported")
+	}
+	clause := map[dialect.LockMode]string{
+		dialect.LockForUpdate: "FOR UPDATE",
+		dialect.LockForShare:  "FOR SHARE",
+	}[mode]
+	if len(tables) > 0 {
+		clause += " OF " + strings.Join(tables, ", ")
+	}
+	switch wait {
+	case dialect.LockNoWait:
+		clause += " NOWAIT"
+	case dialect.LockSkipLocked:
+		clause += " SKIP LOCKED"
+	}
+	return clause, nil
+}
+
+func TestRowLock(t *testing.T) {
+	stmt := sqr.Select(`"id"`).From(`"jobs"`).Limit(1)
+	txc := &DBContext{dialect: &testingLockDialect{}, Tx: &sql.Tx{}}
+
+	cases := []struct {
+		Lock  RowLock
+		Query string
+	}{
+		{RowLock{}, `SELECT "id" FROM "jobs" LIMIT 1`},
+		{RowLock{}.ForUpdate(), `SELECT "id" FROM "jobs" LIMIT 1 FOR UPDATE`},
+		{RowLock{}.ForShare().NoWait(), `SELECT "id" FROM "jobs" LIMIT 1 FOR SHARE NOWAIT`},
+		{RowLock{}.SkipLocked(), `SELECT "id" FROM "jobs" LIMIT 1 FOR UPDATE SKIP LOCKED`},
+	}
+	for _, c := range cases {
+		locked, err := c.Lock.Apply(txc, stmt)
+		if assert.NoError(t, err) {
+			query, _, err := locked.ToSql()
+			assert.NoError(t, err)
+			assert.Equal(t, c.Query, query)
+		}
+	}
+
+	locked, err := RowLock{}.ForUpdate().Apply(txc, stmt, `"jobs"`)
+	if assert.NoError(t, err) {
+		query, _, err := locked.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT "id" FROM "jobs" LIMIT 1 FOR UPDATE OF "jobs"`, query, "locks only rows of given tables")
+	}
+
+	_, err = RowLock{}.ForShare().SkipLocked().Apply(txc, stmt)
+	assert.Error(t, err, "unsupported lock is reported by the dialect")
+
+	dbc := &DBContext{dialect: &testingLockDialect{}}
+	_, err = RowLock{}.ForUpdate().Apply(dbc, stmt)
+	assert.EqualError(t, err, "goen: row-locking requires a transaction, use DBContext.UseTx")
+	_, err = RowLock{}.Apply(dbc, stmt)
+	assert.NoError(t, err, "no lock is allowed without a transaction")
+
+	_, err = RowLock{}.ForUpdate().Apply(&DBContext{dialect: &testingDialect{}, Tx: &sql.Tx{}}, stmt)
+	assert.Error(t, err, "dialect without capabilities")
+
+	assert.NoError(t, RowLock{}.Reject("Count"))
+	assert.EqualError(t, RowLock{}.NoWait().Reject("Count"), "goen: row-locking is not supported by Count")
+}
@@ -3,6 +3,7 @@ package goen
 import (
 	"encoding"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -31,6 +32,9 @@ type MetaTable interface {
 	// ManyToOneReferenceKeys gets all many to one reference meta columns by other entities.
 	ManyToOneReferenceKeys() [][]MetaColumn
 
+	// OneToOneReferenceKeys gets all one to one reference meta columns by other entities.
+	OneToOneReferenceKeys() [][]MetaColumn
+
 	// Columns gets all meta columns of this table.
 	Columns() []MetaColumn
 }
@@ -48,6 +52,8 @@ type metaTable struct {
 
 	manyToOneReferenceKeys [][]MetaColumn
 
+	oneToOneReferenceKeys [][]MetaColumn
+
 	columns []MetaColumn
 }
 
@@ -75,6 +81,10 @@ func (m *metaTable) ManyToOneReferenceKeys() [][]MetaColumn {
 	return m.manyToOneReferenceKeys
 }
 
+func (m *metaTable) OneToOneReferenceKeys() [][]MetaColumn {
+	return m.oneToOneReferenceKeys
+}
+
 func (m *metaTable) Columns() []MetaColumn {
 	return m.columns
 }
@@ -94,6 +104,9 @@ type MetaColumn interface {
 
 	// ColumnName gets this column name.
 	ColumnName() string
+
+	// JSON indicates this column value is serialized as JSON.
+	JSON() bool
 }
 
 type metaColumn struct {
@@ -104,6 +117,8 @@ type metaColumn struct {
 	partOfPrimaryKey bool
 
 	columnName string
+
+	json bool
 }
 
 func (m *metaColumn) Field() reflect.StructField {
@@ -122,6 +137,10 @@ func (m *metaColumn) ColumnName() string {
 	return m.columnName
 }
 
+func (m *metaColumn) JSON() bool {
+	return m.json
+}
+
 var _ MetaColumn = (*metaColumn)(nil)
 
 // MetaSchema manages meta schemata computed by struct (tags).
@@ -151,6 +170,9 @@ type MetaSchema interface {
 	// ManyToOneReferenceKeysOf gets RowKeysone that references to entity by other entities with many to one cardinal.
 	ManyToOneReferenceKeysOf(entity interface{}) []RowKey
 
+	// OneToOneReferenceKeysOf gets RowKeys that references to entity by other entities with one to one cardinal.
+	OneToOneReferenceKeysOf(entity interface{}) []RowKey
+
 	// InsertPatchOf gets a patch that represents insert statement.
 	InsertPatchOf(entity interface{}) *Patch
 
@@ -159,6 +181,10 @@ type MetaSchema interface {
 
 	// DeletePatchOf gets a patch that represents delete statement.
 	DeletePatchOf(entity interface{}) *Patch
+
+	// UpsertPatchOf gets a patch that represents upsert statement, conflicting by conflictColumns or the primary key if empty.
+	// A sole primary key of an integer with omitempty is reported as the auto-increment column.
+	UpsertPatchOf(entity interface{}, conflictColumns ...string) *Patch
 }
 
 type metaSchema struct {
@@ -177,25 +203,31 @@ func NewMetaSchema() MetaSchema {
 func (m *metaSchema) KeyStringFromRowKey(rowKey RowKey) string {
 	m.Compute()
 
+	return keyStringOf(rowKey)
+}
+
+// keyStringOf gets identity string for given RowKey.
+func keyStringOf(rowKey RowKey) string {
 	cols, vals := rowKey.RowKey()
 	params := make([]string, len(cols))
 	for i := range cols {
 		var valStr string
 		perr := safeDo(func() {
-			if m, ok := vals[i].(encoding.TextMarshaler); ok {
+			val := dbValueOf(vals[i])
+			if m, ok := val.(encoding.TextMarshaler); ok {
 				if b, err := m.MarshalText(); err != nil {
 					panic(err)
 				} else {
 					valStr = string(b)
 				}
-			} else if m, ok := vals[i].(encoding.BinaryMarshaler); ok {
+			} else if m, ok := val.(encoding.BinaryMarshaler); ok {
 				if b, err := m.MarshalBinary(); err != nil {
 					panic(err)
 				} else {
 					valStr = hex.EncodeToString(b)
 				}
 			} else {
-				valStr = fmt.Sprint(vals[i])
+				valStr = fmt.Sprint(val)
 			}
 		})
 		if perr != nil {
@@ -216,8 +248,8 @@ func (m *metaSchema) PrimaryKeyOf(entity interface{}) RowKey {
 	rowKey.Table = metaT.TableName()
 	rowKey.Key = map[string]interface{}{}
 	for _, pk := range metaT.PrimaryKey() {
-		rfv := rv.FieldByName(pk.Field().Name)
-		rowKey.Key[pk.ColumnName()] = rfv.Interface()
+		rfv := rv.FieldByIndex(pk.Field().Index)
+		rowKey.Key[pk.ColumnName()] = ConvertValue(rfv.Interface())
 	}
 	return rowKey
 }
@@ -234,8 +266,8 @@ func (m *metaSchema) ReferenceKeysOf(entity interface{}) []RowKey {
 		refe.Table = metaT.TableName()
 		refe.Key = map[string]interface{}{}
 		for _, col := range refeKey {
-			rfv := rv.FieldByName(col.Field().Name)
-			refe.Key[col.ColumnName()] = rfv.Interface()
+			rfv := rv.FieldByIndex(col.Field().Index)
+			refe.Key[col.ColumnName()] = ConvertValue(rfv.Interface())
 		}
 		refes = append(refes, refe)
 	}
@@ -254,8 +286,8 @@ func (m *metaSchema) OneToManyReferenceKeysOf(entity interface{}) []RowKey {
 		refe.Table = metaT.TableName()
 		refe.Key = map[string]interface{}{}
 		for _, col := range refeKey {
-			rfv := rv.FieldByName(col.Field().Name)
-			refe.Key[col.ColumnName()] = rfv.Interface()
+			rfv := rv.FieldByIndex(col.Field().Index)
+			refe.Key[col.ColumnName()] = ConvertValue(rfv.Interface())
 		}
 		refes = append(refes, refe)
 	}
@@ -274,8 +306,28 @@ func (m *metaSchema) ManyToOneReferenceKeysOf(entity interface{}) []RowKey {
 		refe.Table = metaT.TableName()
 		refe.Key = map[string]interface{}{}
 		for _, col := range refeKey {
-			rfv := rv.FieldByName(col.Field().Name)
-			refe.Key[col.ColumnName()] = rfv.Interface()
+			rfv := rv.FieldByIndex(col.Field().Index)
+			refe.Key[col.ColumnName()] = ConvertValue(rfv.Interface())
+		}
+		refes = append(refes, refe)
+	}
+	return refes
+}
+
+func (m *metaSchema) OneToOneReferenceKeysOf(entity interface{}) []RowKey {
+	m.Compute()
+
+	metaT := m.LoadOf(entity)
+	rv := reflect.ValueOf(entity)
+	rv = reflect.Indirect(rv)
+	var refes []RowKey
+	for _, refeKey := range metaT.OneToOneReferenceKeys() {
+		refe := &MapRowKey{}
+		refe.Table = metaT.TableName()
+		refe.Key = map[string]interface{}{}
+		for _, col := range refeKey {
+			rfv := rv.FieldByIndex(col.Field().Index)
+			refe.Key[col.ColumnName()] = ConvertValue(rfv.Interface())
 		}
 		refes = append(refes, refe)
 	}
@@ -296,32 +348,39 @@ func (m *metaSchema) LoadOf(entity interface{}) MetaTable {
 func (m *metaSchema) InsertPatchOf(entity interface{}) *Patch {
 	metaT := m.LoadOf(entity)
 	var (
-		cols = make([]string, 0, len(metaT.Columns()))
-		vals = make([]interface{}, 0, len(metaT.Columns()))
+		cols     = make([]string, 0, len(metaT.Columns()))
+		vals     = make([]interface{}, 0, len(metaT.Columns()))
+		patchErr error
 	)
 	rv := reflect.ValueOf(entity)
 	rv = reflect.Indirect(rv)
 	for _, metaC := range metaT.Columns() {
-		rfv := rv.FieldByName(metaC.Field().Name)
+		rfv := rv.FieldByIndex(metaC.Field().Index)
 		if !rfv.IsValid() || metaC.OmitEmpty() && isEmptyValue(rfv) {
 			continue
 		}
+		val, err := columnValue(metaC, rfv)
+		if err != nil && patchErr == nil {
+			patchErr = err
+		}
 		cols = append(cols, metaC.ColumnName())
-		vals = append(vals, rfv.Interface())
+		vals = append(vals, val)
 	}
 	return &Patch{
 		Kind:      PatchInsert,
 		TableName: metaT.TableName(),
 		Columns:   cols,
 		Values:    vals,
+		Err:       patchErr,
 	}
 }
 
 func (m *metaSchema) UpdatePatchOf(entity interface{}) *Patch {
 	metaT := m.LoadOf(entity)
 	var (
-		cols = make([]string, 0, len(metaT.Columns()))
-		vals = make([]interface{}, 0, len(metaT.Columns()))
+		cols     = make([]string, 0, len(metaT.Columns()))
+		vals     = make([]interface{}, 0, len(metaT.Columns()))
+		patchErr error
 	)
 	rv := reflect.ValueOf(entity)
 	rv = reflect.Indirect(rv)
@@ -330,12 +389,16 @@ func (m *metaSchema) UpdatePatchOf(entity interface{}) *Patch {
 		if metaC.PartOfPrimaryKey() {
 			continue
 		}
-		rfv := rv.FieldByName(metaC.Field().Name)
+		rfv := rv.FieldByIndex(metaC.Field().Index)
 		if !rfv.IsValid() || metaC.OmitEmpty() && isEmptyValue(rfv) {
 			continue
 		}
+		val, err := columnValue(metaC, rfv)
+		if err != nil && patchErr == nil {
+			patchErr = err
+		}
 		cols = append(cols, metaC.ColumnName())
-		vals = append(vals, rfv.Interface())
+		vals = append(vals, val)
 	}
 	return &Patch{
 		Kind:      PatchUpdate,
@@ -344,6 +407,7 @@ func (m *metaSchema) UpdatePatchOf(entity interface{}) *Patch {
 		Values:    vals,
 		// update only given entitty filtered by its primary key
 		RowKey: m.PrimaryKeyOf(entity),
+		Err:    patchErr,
 	}
 }
 
@@ -357,6 +421,41 @@ func (m *metaSchema) DeletePatchOf(entity interface{}) *Patch {
 	}
 }
 
+func (m *metaSchema) UpsertPatchOf(entity interface{}, conflictColumns ...string) *Patch {
+	metaT := m.LoadOf(entity)
+	patch := m.InsertPatchOf(entity)
+	patch.Kind = PatchUpsert
+	if len(conflictColumns) == 0 {
+		patch.RowKey = m.PrimaryKeyOf(entity)
+	} else {
+		rv := reflect.Indirect(reflect.ValueOf(entity))
+		rowKey := &MapRowKey{
+			Table: metaT.TableName(),
+			Key:   map[string]interface{}{},
+		}
+		for _, col := range conflictColumns {
+			metaC, ok := columnByName(metaT, col)
+			if !ok {
+				panic(fmt.Sprintf("goen: unknown conflict column %q of %s", col, metaT.TableName()))
+			}
+			val, err := columnValue(metaC, rv.FieldByIndex(metaC.Field().Index))
+			if err != nil && patch.Err == nil {
+				patch.Err = err
+			}
+			rowKey.Key[col] = val
+		}
+		patch.RowKey = rowKey
+	}
+	if pk := metaT.PrimaryKey(); len(pk) == 1 && pk[0].OmitEmpty() {
+		switch pk[0].Field().Type.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			patch.AutoIncrementColumn = pk[0].ColumnName()
+		}
+	}
+	return patch
+}
+
 func (m *metaSchema) typeOf(entity interface{}) reflect.Type {
 	typ := reflect.TypeOf(entity)
 	for typ.Kind() == reflect.Ptr {
@@ -409,8 +508,12 @@ func (m *metaSchema) computeOf(typ reflect.Type) MetaTable {
 			partOfPrimaryKey: isPrimaryKey,
 			columnName:       internal.ColumnName(field),
 			omitEmpty:        internal.OmitEmpty(field),
+			json:             internal.IsJSONField(field),
 		}
 		if isPrimaryKey {
+			if col.json {
+				panic(fmt.Sprintf("goen: json column must not be a part of primary key on %s.%s", typ.Name(), col.field.Name))
+			}
 			tbl.primaryKey = append(tbl.primaryKey, col)
 		}
 		tbl.columns = append(tbl.columns, col)
@@ -431,6 +534,9 @@ func (m *metaSchema) computeOf(typ reflect.Type) MetaTable {
 				return false
 			} else if !internal.IsForeignKeyField(refeField) {
 				return false
+			} else if internal.IsManyToManyField(refeField) {
+				// references to the join table, not to typ
+				return false
 			}
 			refeFieldTyp := refeField.Type().Value().(reflect.Type)
 			refeFieldTyp = elemType(refeFieldTyp)
@@ -452,14 +558,18 @@ func (m *metaSchema) computeOf(typ reflect.Type) MetaTable {
 					partOfPrimaryKey: internal.IsPrimaryKeyField(foreField),
 					columnName:       internal.ColumnName(foreField),
 					omitEmpty:        internal.OmitEmpty(foreField),
+					json:             internal.IsJSONField(foreField),
 				})
 			}
 			// now refeTyp == Typ, another entity's field are:
 			// []*Typ or []Typ : refeTyp (1) - typ (*)
+			// *Typ or Typ with refeTyp's primary key : refeTyp (1) - typ (1)
 			// *Typ or Typ : refeTyp (*) - typ (1)
 			switch {
 			case internal.IsOneToManyField(refeField):
 				tbl.oneToManyReferenceKeys = append(tbl.oneToManyReferenceKeys, key)
+			case internal.IsOneToOneField(refeStrct, refeField):
+				tbl.oneToOneReferenceKeys = append(tbl.oneToOneReferenceKeys, key)
 			case internal.IsManyToOneField(refeField):
 				tbl.manyToOneReferenceKeys = append(tbl.manyToOneReferenceKeys, key)
 			}
@@ -471,6 +581,24 @@ func (m *metaSchema) computeOf(typ reflect.Type) MetaTable {
 
 var _ MetaSchema = (*metaSchema)(nil)
 
+// columnValue gets a value of rfv for metaC to be stored.
+func columnValue(metaC MetaColumn, rfv reflect.Value) (interface{}, error) {
+	if !metaC.JSON() {
+		return ConvertValue(rfv.Interface()), nil
+	}
+	switch rfv.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		if rfv.IsNil() {
+			return nil, nil
+		}
+	}
+	b, err := json.Marshal(rfv.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("goen: unable to marshal column %q as json: %s", metaC.ColumnName(), err)
+	}
+	return string(b), nil
+}
+
 func isEmptyValue(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
@@ -57,11 +57,183 @@ type Text struct {
 	Msg string
 }
 
+type Member struct {
+	// generated by the database
+	MemberID int64 `goen:"" primary_key:",omitempty"`
+
+	Email string
+}
+
+type Preference struct {
+	PreferenceID int               `goen:"" primary_key:""`
+	Settings     map[string]string `column:",json"`
+	Tags         []string          `column:",json,omitempty"`
+	Extra        *CommonFields     `column:"extra,json"`
+}
+
+func TestMetaSchemaJSON(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(Preference{})
+	meta.Compute()
+
+	metaT := meta.LoadOf(&Preference{})
+	for _, metaC := range metaT.Columns() {
+		assert.Equal(t, metaC.ColumnName() != "preference_id", metaC.JSON(), "column %q", metaC.ColumnName())
+	}
+
+	patch := meta.InsertPatchOf(&Preference{
+		PreferenceID: 1,
+		Settings: map[string]string{
+			"theme": "dark",
+		},
+	})
+	assert.Equal(t, &Patch{
+		Kind:      PatchInsert,
+		TableName: "preference",
+		Columns:   []string{"preference_id", "settings", "extra"},
+		Values:    []interface{}{1, `{"theme":"dark"}`, nil},
+	}, patch)
+
+	patch = meta.UpdatePatchOf(&Preference{
+		PreferenceID: 1,
+		Tags:         []string{"a", "b"},
+		Extra:        &CommonFields{},
+	})
+	assert.Equal(t, &Patch{
+		Kind:      PatchUpdate,
+		TableName: "preference",
+		Columns:   []string{"settings", "tags", "extra"},
+		Values:    []interface{}{nil, `["a","b"]`, `{"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"}`},
+		RowKey: &MapRowKey{
+			Table: "preference",
+			Key: map[string]interface{}{
+				"preference_id": 1,
+			},
+		},
+	}, patch)
+}
+
+type Unmarshalable struct {
+	ID    int         `goen:"" primary_key:""`
+	Value interface{} `column:",json"`
+}
+
+type JSONKey struct {
+	Key map[string]string `goen:"" primary_key:",json"`
+}
+
+func TestMetaSchemaJSONError(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(Unmarshalable{})
+	meta.Compute()
+
+	patch := meta.InsertPatchOf(&Unmarshalable{ID: 1, Value: make(chan int)})
+	assert.Error(t, patch.Err, "a marshal error is kept in the patch")
+	patch = meta.UpdatePatchOf(&Unmarshalable{ID: 1, Value: make(chan int)})
+	assert.Error(t, patch.Err)
+
+	patches := NewPatchList()
+	patches.PushBack(patch)
+	for _, compiler := range []PatchCompiler{DefaultCompiler, BulkCompiler} {
+		sqlizers := compiler.Compile(&CompilerOptions{
+			Dialect: &testingDialect{},
+			Patches: patches,
+		})
+		if assert.Equal(t, 1, sqlizers.Len()) {
+			_, _, err := sqlizers.Front().GetValue().ToSql()
+			assert.EqualError(t, err, patch.Err.Error(), "reported by SaveChanges")
+		}
+	}
+
+	assert.Panics(t, func() {
+		meta := NewMetaSchema()
+		meta.Register(JSONKey{})
+		meta.Compute()
+	}, "json primary key is rejected")
+}
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type Invoice struct {
+	InvoiceID int `table:"invoice" primary_key:""`
+
+	Billing Address `embed:"billing_"`
+
+	Shipping Address `embed:""`
+
+	CommonFields
+}
+
+func TestMetaSchemaEmbed(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(Invoice{})
+	meta.Compute()
+
+	metaT := meta.LoadOf(&Invoice{})
+	var cols []string
+	for _, metaC := range metaT.Columns() {
+		cols = append(cols, metaC.ColumnName())
+	}
+	assert.Equal(t, []string{"invoice_id", "billing_street", "billing_city", "shipping_street", "shipping_city", "created_at", "updated_at"}, cols)
+
+	createdAt := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	patch := meta.InsertPatchOf(&Invoice{
+		InvoiceID: 1,
+		Billing:   Address{"1-2-3 Chiyoda", "Tokyo"},
+		Shipping:  Address{City: "Osaka"},
+		CommonFields: CommonFields{
+			CreatedAt: createdAt,
+		},
+	})
+	assert.Equal(t, &Patch{
+		Kind:      PatchInsert,
+		TableName: "invoice",
+		Columns:   cols,
+		Values:    []interface{}{1, "1-2-3 Chiyoda", "Tokyo", "", "Osaka", createdAt, time.Time{}},
+	}, patch)
+}
+
+type Author struct {
+	AuthorID int `table:"author" primary_key:""`
+
+	Name string
+
+	Books []*Book `foreign_key:"author_id"`
+
+	NumBooks int `aggregate:"count,Books"`
+}
+
+type Book struct {
+	BookID int `table:"book" primary_key:""`
+
+	AuthorID int
+}
+
+func TestMetaSchemaAggregate(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(Author{})
+	meta.Register(Book{})
+	meta.Compute()
+
+	author := &Author{AuthorID: 1, Name: "kamichidu", NumBooks: 3}
+	assert.Equal(t, &Patch{
+		Kind:      PatchInsert,
+		TableName: "author",
+		Columns:   []string{"author_id", "name"},
+		Values:    []interface{}{1, "kamichidu"},
+	}, meta.InsertPatchOf(author))
+	assert.Equal(t, []string{"name"}, meta.UpdatePatchOf(author).Columns)
+}
+
 func TestMetaSchema(t *testing.T) {
 	meta := NewMetaSchema()
 	meta.Register(Blog{})
 	meta.Register(Post{})
 	meta.Register(Text{})
+	meta.Register(Member{})
 	meta.Compute()
 
 	t.Run("PrimaryKeyOf", func(t *testing.T) {
@@ -185,6 +357,40 @@ func TestMetaSchema(t *testing.T) {
 			},
 		}, patch)
 	})
+	t.Run("UpsertPatchOf", func(t *testing.T) {
+		patch := meta.UpsertPatchOf(&Text{TextID: 1, Msg: "hello"})
+		assert.Equal(t, &Patch{
+			Kind:      PatchUpsert,
+			TableName: "text",
+			Columns:   []string{"text_id", "msg"},
+			Values:    []interface{}{1, "hello"},
+			RowKey: &MapRowKey{
+				Table: "text",
+				Key: map[string]interface{}{
+					"text_id": 1,
+				},
+			},
+		}, patch)
+
+		patch = meta.UpsertPatchOf(&Member{Email: "x@example.com"}, "email")
+		assert.Equal(t, &Patch{
+			Kind:      PatchUpsert,
+			TableName: "member",
+			Columns:   []string{"email"},
+			Values:    []interface{}{"x@example.com"},
+			RowKey: &MapRowKey{
+				Table: "member",
+				Key: map[string]interface{}{
+					"email": "x@example.com",
+				},
+			},
+			AutoIncrementColumn: "member_id",
+		}, patch, "a sole primary key with omitempty is auto-increment")
+
+		assert.Panics(t, func() {
+			meta.UpsertPatchOf(&Member{}, "unknown")
+		})
+	})
 	t.Run("LoadOf", func(t *testing.T) {
 		meta := meta.LoadOf(new(Blog))
 		typ := reflect.TypeOf(Blog{})
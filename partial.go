@@ -0,0 +1,69 @@
+package goen
+
+import (
+	"sync"
+)
+
+// partialRows holds loaded column names of partially loaded rows, keyed by the key string of the primary key.
+// It's owned by a DBContext, so marks are released with the DBContext.
+type partialRows struct {
+	mu sync.Mutex
+
+	loaded map[string]map[string]bool
+}
+
+func newPartialRows() *partialRows {
+	return &partialRows{loaded: map[string]map[string]bool{}}
+}
+
+// MarkPartial marks the row of entity as partially loaded, that only given columns are loaded.
+// Update patches for the row write only the loaded columns, even for a copy of entity.
+// When columns is nil, the row is marked as fully loaded.
+func (dbc *DBContext) MarkPartial(meta MetaSchema, entity interface{}, columns []string) {
+	if dbc.partials == nil {
+		dbc.partials = newPartialRows()
+	}
+
+	dbc.partials.mu.Lock()
+	defer dbc.partials.mu.Unlock()
+
+	if columns == nil {
+		if len(dbc.partials.loaded) > 0 {
+			delete(dbc.partials.loaded, meta.KeyStringFromRowKey(meta.PrimaryKeyOf(entity)))
+		}
+		return
+	}
+	loaded := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		loaded[col] = true
+	}
+	dbc.partials.loaded[meta.KeyStringFromRowKey(meta.PrimaryKeyOf(entity))] = loaded
+}
+
+// restrictPartial gets an update patch writing only the loaded columns, if the row of patch is partial.
+// It returns nil if no loaded column is left, since there's nothing to write.
+func (dbc *DBContext) restrictPartial(patch *Patch) *Patch {
+	if dbc.partials == nil || patch.Kind != PatchUpdate || patch.RowKey == nil {
+		return patch
+	}
+	dbc.partials.mu.Lock()
+	loaded, ok := dbc.partials.loaded[keyStringOf(patch.RowKey)]
+	dbc.partials.mu.Unlock()
+	if !ok {
+		return patch
+	}
+
+	restricted := *patch
+	restricted.Columns = make([]string, 0, len(patch.Columns))
+	restricted.Values = make([]interface{}, 0, len(patch.Values))
+	for i, col := range patch.Columns {
+		if loaded[col] {
+			restricted.Columns = append(restricted.Columns, col)
+			restricted.Values = append(restricted.Values, patch.Values[i])
+		}
+	}
+	if len(restricted.Columns) == 0 {
+		return nil
+	}
+	return &restricted
+}
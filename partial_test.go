@@ -0,0 +1,42 @@
+package goen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBContextMarkPartial(t *testing.T) {
+	m := new(metaSchema)
+	m.Register(Preference{})
+	m.Compute()
+
+	dbc := &DBContext{patchBuffer: NewPatchList()}
+	columnsOf := func(entity *Preference) []string {
+		dbc.Patch(m.UpdatePatchOf(entity))
+		return dbc.patchBuffer.Remove(dbc.patchBuffer.Back()).Columns
+	}
+
+	full := &Preference{PreferenceID: 1}
+	partials := []Preference{{PreferenceID: 2}}
+	dbc.MarkPartial(m, &partials[0], []string{"preference_id", "extra"})
+	assert.Equal(t, []string{"settings", "extra"}, columnsOf(full))
+	assert.Equal(t, []string{"extra"}, columnsOf(&partials[0]), "writes only loaded columns")
+
+	copied := partials[0]
+	assert.Equal(t, []string{"extra"}, columnsOf(&copied), "a copy is also partial")
+
+	dbc.MarkPartial(m, &partials[0], []string{"preference_id", "settings"})
+	assert.Equal(t, []string{"settings"}, columnsOf(&partials[0]), "marks again with other columns")
+
+	dbc.MarkPartial(m, &partials[0], []string{"preference_id"})
+	dbc.Patch(m.UpdatePatchOf(&partials[0]))
+	assert.Equal(t, 0, dbc.patchBuffer.Len(), "drops an update without loaded columns")
+
+	dbc.MarkPartial(m, &partials[0], nil)
+	assert.Equal(t, []string{"settings", "extra"}, columnsOf(&partials[0]), "fully loaded row writes all columns")
+
+	other := &DBContext{patchBuffer: NewPatchList()}
+	other.Patch(m.UpdatePatchOf(&Preference{PreferenceID: 2}))
+	assert.Equal(t, []string{"settings", "extra"}, other.patchBuffer.Back().GetValue().Columns, "marks are owned by a DBContext")
+}
@@ -1,13 +1,33 @@
 package goen
 
+import (
+	"errors"
+	"fmt"
+
+	sqr "github.com/Masterminds/squirrel"
+)
+
+// ErrNoAssignments is reported by SaveChanges for UpdateAll without assignments.
+var ErrNoAssignments = errors.New("goen: UpdateAll requires at least one assignment")
+
 type PatchKind int
 
 const (
 	PatchInsert PatchKind = iota
 	PatchUpdate
 	PatchDelete
+	PatchUpsert
+	// PatchUpdateAll updates all rows matching Where at once.
+	PatchUpdateAll
+	// PatchDeleteAll deletes all rows matching Where at once.
+	PatchDeleteAll
 )
 
+// PatchResult holds a result of a patch, it's filled by SaveChanges.
+type PatchResult struct {
+	RowsAffected int64
+}
+
 type Patch struct {
 	Kind PatchKind
 
@@ -18,6 +38,21 @@ type Patch struct {
 	Columns []string
 
 	Values []interface{}
+
+	// AutoIncrementColumn is a column generated by the database, for an upsert patch.
+	// When a dialect implements dialect.LastInsertIDUpserter, sql.Result.LastInsertId reports it
+	// even if the existing row is updated.
+	AutoIncrementColumn string
+
+	// Where filters rows for an update all or delete all patch, nil means all rows.
+	Where sqr.Sqlizer
+
+	// Result receives the result of an update all or delete all patch, or nil.
+	Result *PatchResult
+
+	// Err is an error found while making this patch; e.g. a json column failed to marshal.
+	// Compilers report it as an error of SaveChanges instead of a statement.
+	Err error
 }
 
 func InsertPatch(tableName string, columns []string, values []interface{}) *Patch {
@@ -64,3 +99,72 @@ func DeletePatch(tableName string, rowKey RowKey) *Patch {
 		RowKey:    rowKey,
 	}
 }
+
+// UpsertPatch makes a patch inserting a row, or updating the existing row conflicting by rowKey's columns.
+// Columns other than rowKey's columns are updated.
+func UpsertPatch(tableName string, columns []string, values []interface{}, rowKey RowKey) *Patch {
+	if tableName == "" {
+		panic("goen: no tableName provided")
+	}
+	if len(columns) == 0 || len(columns) != len(values) {
+		panic("goen: columns and values must have least 1 element or length mismatched")
+	}
+
+	return &Patch{
+		Kind:      PatchUpsert,
+		TableName: tableName,
+		Columns:   columns,
+		Values:    values,
+		RowKey:    rowKey,
+	}
+}
+
+// UpdateAllPatch makes a patch updating all rows matching where by a statement.
+// A value may be sqr.Sqlizer for an expression; e.g. sqr.Expr(`"count" + ?`, 1).
+func UpdateAllPatch(tableName string, columns []string, values []interface{}, where sqr.Sqlizer) *Patch {
+	if tableName == "" {
+		panic("goen: no tableName provided")
+	}
+	if len(columns) == 0 || len(columns) != len(values) {
+		panic("goen: columns and values must have least 1 element or length mismatched")
+	}
+
+	return &Patch{
+		Kind:      PatchUpdateAll,
+		TableName: tableName,
+		Columns:   columns,
+		Values:    values,
+		Where:     where,
+		Result:    &PatchResult{},
+	}
+}
+
+// RejectedPatch makes a patch of kind on tableName, which only reports err by SaveChanges.
+// It's used for a statement which can't be built as requested; e.g. UpdateAll with Limit.
+func RejectedPatch(kind PatchKind, tableName string, err error) *Patch {
+	return &Patch{
+		Kind:      kind,
+		TableName: tableName,
+		Result:    &PatchResult{},
+		Err:       err,
+	}
+}
+
+// UnappliedClauseError makes an error that op is unable to apply clause of a query builder; e.g. "Limit" for DeleteAll.
+func UnappliedClauseError(op string, clause string) error {
+	return fmt.Errorf("goen: %s is unable to apply %s", op, clause)
+}
+
+// DeleteAllPatch makes a patch deleting all rows matching where by a statement.
+func DeleteAllPatch(tableName string, where sqr.Sqlizer) *Patch {
+	if tableName == "" {
+		panic("goen: no tableName provided")
+	}
+
+	return &Patch{
+		Kind:      PatchDeleteAll,
+		TableName: tableName,
+		Where:     where,
+		Result:    &PatchResult{},
+	}
+}
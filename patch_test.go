@@ -3,6 +3,7 @@ package goen
 import (
 	"testing"
 
+	sqr "github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -100,3 +101,59 @@ func TestDeletePatch(t *testing.T) {
 		})
 	}, "panics when tableName is empty")
 }
+
+func TestUpsertPatch(t *testing.T) {
+	rowKey := &MapRowKey{
+		Table: "testing",
+		Key: map[string]interface{}{
+			"attr1": 1,
+		},
+	}
+	assert.Equal(t, &Patch{
+		Kind:      PatchUpsert,
+		TableName: "testing",
+		Columns:   []string{"attr1", "attr2"},
+		Values:    []interface{}{1, "2"},
+		RowKey:    rowKey,
+	}, UpsertPatch("testing", []string{"attr1", "attr2"}, []interface{}{1, "2"}, rowKey))
+
+	assert.Panics(t, func() {
+		UpsertPatch("", []string{"attr1", "attr2"}, []interface{}{1, "2"}, rowKey)
+	}, "panics when tableName is empty")
+
+	assert.Panics(t, func() {
+		UpsertPatch("testing", []string{"attr1", "attr2"}, []interface{}{}, rowKey)
+	}, "panics when columns length and values length are mismatched")
+}
+
+func TestUpdateAllPatch(t *testing.T) {
+	where := sqr.Eq{"attr3": 9999}
+	assert.Equal(t, &Patch{
+		Kind:      PatchUpdateAll,
+		TableName: "testing",
+		Columns:   []string{"attr1", "attr2"},
+		Values:    []interface{}{1, "2"},
+		Where:     where,
+		Result:    &PatchResult{},
+	}, UpdateAllPatch("testing", []string{"attr1", "attr2"}, []interface{}{1, "2"}, where))
+
+	assert.Panics(t, func() {
+		UpdateAllPatch("", []string{"attr1", "attr2"}, []interface{}{1, "2"}, where)
+	}, "panics when tableName is empty")
+
+	assert.Panics(t, func() {
+		UpdateAllPatch("testing", []string{}, []interface{}{}, where)
+	}, "panics when columns and values are empty")
+}
+
+func TestDeleteAllPatch(t *testing.T) {
+	assert.Equal(t, &Patch{
+		Kind:      PatchDeleteAll,
+		TableName: "testing",
+		Result:    &PatchResult{},
+	}, DeleteAllPatch("testing", nil))
+
+	assert.Panics(t, func() {
+		DeleteAllPatch("", nil)
+	}, "panics when tableName is empty")
+}
@@ -2,6 +2,7 @@ package goen
 
 import (
 	"sort"
+	"strings"
 
 	sqr "github.com/Masterminds/squirrel"
 	"github.com/kamichidu/goen/dialect"
@@ -59,7 +60,82 @@ func (key *MapRowKey) toEq() sqr.Eq {
 	// copy to modify column name
 	expr := sqr.Eq{}
 	for col, val := range key.Key {
-		expr[col] = val
+		expr[col] = ConvertValue(val)
 	}
 	return expr
 }
+
+// RowKeyList is a list of RowKeys which have the same columns.
+type RowKeyList []RowKey
+
+// Chunks splits the list into chunks which have size row keys at most.
+// When size is 0 or negative, it returns the list as a chunk.
+func (l RowKeyList) Chunks(size int) []RowKeyList {
+	if len(l) == 0 {
+		return nil
+	} else if size <= 0 || len(l) <= size {
+		return []RowKeyList{l}
+	}
+	chunks := make([]RowKeyList, 0, (len(l)+size-1)/size)
+	for len(l) > size {
+		chunks = append(chunks, l[:size:size])
+		l = l[size:]
+	}
+	return append(chunks, l)
+}
+
+// Unique gets the list without duplicated row keys, keeping the order.
+func (l RowKeyList) Unique() RowKeyList {
+	seen := make(map[string]bool, len(l))
+	uniq := make(RowKeyList, 0, len(l))
+	for _, rowKey := range l {
+		keyStr := keyStringOf(rowKey)
+		if !seen[keyStr] {
+			seen[keyStr] = true
+			uniq = append(uniq, rowKey)
+		}
+	}
+	return uniq
+}
+
+// ToSqlizerWithDialect gets a condition that matches rows by any of the row keys.
+// It renders "col IN (...)" for single column keys, and "(col1, col2) IN ((...), ...)" for composite keys.
+// Composite keys are OR-ed equalities instead, when the dialect does not support row values.
+func (l RowKeyList) ToSqlizerWithDialect(d dialect.Dialect) sqr.Sqlizer {
+	if len(l) == 0 {
+		// always false
+		return sqr.Expr("1=0")
+	}
+	cols, _ := l[0].RowKey()
+	args := make([]interface{}, 0, len(l)*len(cols))
+	for _, rowKey := range l {
+		keyCols, vals := rowKey.RowKey()
+		if strings.Join(keyCols, ",") != strings.Join(cols, ",") {
+			panic("goen: row keys have different columns " + strings.Join(cols, ",") + " and " + strings.Join(keyCols, ","))
+		}
+		for _, val := range vals {
+			args = append(args, ConvertValue(val))
+		}
+	}
+	if len(cols) == 1 {
+		return sqr.Eq{d.Quote(cols[0]): args}
+	}
+	if capa, ok := d.(dialect.Capabilities); !ok || !capa.SupportsRowValues() {
+		cond := sqr.Or{}
+		for i := 0; i < len(args); i += len(cols) {
+			eqs := sqr.And{}
+			for j := range cols {
+				eqs = append(eqs, sqr.Eq{d.Quote(cols[j]): args[i+j]})
+			}
+			cond = append(cond, eqs)
+		}
+		return cond
+	}
+	quoted := make([]string, len(cols))
+	for i := range cols {
+		quoted[i] = d.Quote(cols[i])
+	}
+	tuple := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(cols)), ", ") + ")"
+	tuples := strings.TrimSuffix(strings.Repeat(tuple+", ", len(l)), ", ")
+	return sqr.Expr("("+strings.Join(quoted, ", ")+") IN ("+tuples+")", args...)
+}
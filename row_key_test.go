@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +40,69 @@ func TestMapRowKey(t *testing.T) {
 		`"c"`: 0,
 	}, rkey.ToSqlizerWithDialect(new(testingDialect)))
 }
+
+func TestRowKeyList(t *testing.T) {
+	rowKeyOf := func(a, b interface{}) RowKey {
+		key := map[string]interface{}{"a": a}
+		if b != nil {
+			key["b"] = b
+		}
+		return &MapRowKey{Table: "testing", Key: key}
+	}
+
+	t.Run("Chunks", func(t *testing.T) {
+		l := RowKeyList{rowKeyOf(1, nil), rowKeyOf(2, nil), rowKeyOf(3, nil)}
+		assert.Nil(t, RowKeyList(nil).Chunks(2))
+		assert.Equal(t, []RowKeyList{l}, l.Chunks(0))
+		assert.Equal(t, []RowKeyList{l}, l.Chunks(3))
+		assert.Equal(t, []RowKeyList{l[:2], l[2:]}, l.Chunks(2))
+		assert.Equal(t, []RowKeyList{l[:1], l[1:2], l[2:]}, l.Chunks(1))
+	})
+	t.Run("Unique", func(t *testing.T) {
+		l := RowKeyList{rowKeyOf(1, 2), rowKeyOf(3, 4), rowKeyOf(1, 2), rowKeyOf(1, 3)}
+		assert.Equal(t, RowKeyList{l[0], l[1], l[3]}, l.Unique())
+	})
+	t.Run("ToSqlizerWithDialect", func(t *testing.T) {
+		cases := []struct {
+			Dialect dialect.Dialect
+			List    RowKeyList
+			Query   string
+			Args    []interface{}
+		}{
+			{
+				&testingDialect{},
+				nil,
+				`1=0`,
+				nil,
+			},
+			{
+				&testingDialect{},
+				RowKeyList{rowKeyOf(1, nil), rowKeyOf(2, nil)},
+				`"a" IN (?,?)`,
+				[]interface{}{1, 2},
+			},
+			{
+				&testingDialect{},
+				RowKeyList{rowKeyOf(1, 2), rowKeyOf(3, 4)},
+				`(("a" = ? AND "b" = ?) OR ("a" = ? AND "b" = ?))`,
+				[]interface{}{1, 2, 3, 4},
+			},
+			{
+				&testingCapabilitiesDialect{rowValues: true},
+				RowKeyList{rowKeyOf(1, 2), rowKeyOf(3, 4)},
+				`("a", "b") IN ((?, ?), (?, ?))`,
+				[]interface{}{1, 2, 3, 4},
+			},
+		}
+		for _, c := range cases {
+			query, args, err := c.List.ToSqlizerWithDialect(c.Dialect).ToSql()
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.Query, query)
+				assert.Equal(t, c.Args, args)
+			}
+		}
+		assert.Panics(t, func() {
+			RowKeyList{rowKeyOf(1, nil), rowKeyOf(1, 2)}.ToSqlizerWithDialect(&testingDialect{})
+		}, "panics if row keys have different columns")
+	})
+}
@@ -14,6 +14,8 @@ const (
 	CardinalityNone Cardinality = iota
 	CardinalityOneToMany
 	CardinalityManyToOne
+	CardinalityManyToMany
+	CardinalityOneToOne
 )
 
 func (v Cardinality) toCacheKey(keyStr string) string {
@@ -24,6 +26,10 @@ func (v Cardinality) toCacheKey(keyStr string) string {
 		return keyStr + "#cardinality=OneToMany"
 	case CardinalityManyToOne:
 		return keyStr + "#cardinality=ManyToOne"
+	case CardinalityManyToMany:
+		return keyStr + "#cardinality=ManyToMany"
+	case CardinalityOneToOne:
+		return keyStr + "#cardinality=OneToOne"
 	default:
 		panic(fmt.Sprintf("goen: invalid Cardinality: %v", v))
 	}
@@ -82,6 +88,26 @@ func (sc *ScopeCache) AddObject(v interface{}) {
 		refeKey := CardinalityManyToOne.toCacheKey(sc.Meta.KeyStringFromRowKey(refe))
 		sc.data[refeKey] = v
 	}
+	// add v as one-to-one relation
+	refes = sc.Meta.OneToOneReferenceKeysOf(v)
+	for _, refe := range refes {
+		refeKey := CardinalityOneToOne.toCacheKey(sc.Meta.KeyStringFromRowKey(refe))
+		sc.data[refeKey] = v
+	}
+}
+
+// AddLinkedObjects adds objects linked with rowKey of the join table, as many-to-many relation.
+// Without objects, it stores an empty list to know rowKey has no links.
+func (sc *ScopeCache) AddLinkedObjects(rowKey RowKey, v ...interface{}) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	key := CardinalityManyToMany.toCacheKey(sc.Meta.KeyStringFromRowKey(rowKey))
+	var slice []interface{}
+	if cached, ok := sc.data[key]; ok {
+		slice = cached.([]interface{})
+	}
+	sc.data[key] = append(slice, v...)
 }
 
 // HasObject checks stored object with given cardinality is exists or not.
@@ -107,6 +133,8 @@ func (sc *ScopeCache) RemoveObject(v interface{}) {
 			CardinalityNone,
 			CardinalityOneToMany,
 			CardinalityManyToOne,
+			CardinalityManyToMany,
+			CardinalityOneToOne,
 		} {
 			delete(sc.data, cardinality.toCacheKey(key))
 		}
@@ -77,3 +77,78 @@ func TestScopeCache(t *testing.T) {
 	assert.Equal(t, false, sc.HasObject(CardinalityNone, userKey), "user's user_id key was deleted")
 	assert.Equal(t, false, sc.HasObject(CardinalityManyToOne, userEmailKey), "user's email_id key was deleted")
 }
+
+func TestScopeCacheLinkedObjects(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(new(User))
+	meta.Compute()
+
+	sc := NewScopeCache(meta)
+	linkKey := func(userID int64) RowKey {
+		return &MapRowKey{
+			Table: "user_groups",
+			Key: map[string]interface{}{
+				"user_id": userID,
+			},
+		}
+	}
+	assert.False(t, sc.HasObject(CardinalityManyToMany, linkKey(1)))
+
+	users := []*User{{UserID: 2}, {UserID: 3}}
+	sc.AddLinkedObjects(linkKey(1), users[0])
+	sc.AddLinkedObjects(linkKey(1), users[1])
+	sc.AddLinkedObjects(linkKey(4))
+	assert.True(t, sc.HasObject(CardinalityManyToMany, linkKey(1)))
+	assert.Equal(t, []interface{}{users[0], users[1]}, sc.GetObject(CardinalityManyToMany, linkKey(1)))
+	assert.True(t, sc.HasObject(CardinalityManyToMany, linkKey(4)), "no links is also cached")
+	assert.Len(t, sc.GetObject(CardinalityManyToMany, linkKey(4)), 0)
+	assert.False(t, sc.HasObject(CardinalityOneToMany, linkKey(1)))
+}
+
+type Account struct {
+	AccountID int64 `goen:"" primary_key:""`
+
+	Setting *AccountSetting `foreign_key:"account_id"`
+}
+
+type AccountSetting struct {
+	AccountSettingID int64 `goen:"" primary_key:""`
+
+	AccountID int64
+
+	Account *Account `foreign_key:"account_id"`
+}
+
+func TestScopeCacheOneToOne(t *testing.T) {
+	meta := NewMetaSchema()
+	meta.Register(new(Account))
+	meta.Register(new(AccountSetting))
+	meta.Compute()
+
+	sc := NewScopeCache(meta)
+	accountKey := &MapRowKey{
+		Table: "account_setting",
+		Key: map[string]interface{}{
+			"account_id": 1,
+		},
+	}
+	setting := &AccountSetting{
+		AccountSettingID: 2,
+		AccountID:        1,
+	}
+	sc.AddObject(setting)
+	assert.Exactly(t, setting, sc.GetObject(CardinalityOneToOne, accountKey), "GetObject returns cached entity by account_id key")
+	assert.False(t, sc.HasObject(CardinalityManyToOne, accountKey), "account_setting is not referenced as many-to-one")
+
+	account := &Account{AccountID: 1}
+	sc.AddObject(account)
+	assert.Exactly(t, account, sc.GetObject(CardinalityManyToOne, &MapRowKey{
+		Table: "account",
+		Key: map[string]interface{}{
+			"account_id": 1,
+		},
+	}), "account is referenced as many-to-one from account_setting")
+
+	sc.RemoveObject(setting)
+	assert.False(t, sc.HasObject(CardinalityOneToOne, accountKey), "account_setting's account_id key was deleted")
+}
@@ -0,0 +1,138 @@
+package goen
+
+import (
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/kamichidu/goen/dialect"
+)
+
+// SqlizerUnwrapper is implemented by generated entity sqlizers, to get the wrapped sqlizer.
+type SqlizerUnwrapper interface {
+	Unwrap() sqr.Sqlizer
+}
+
+// EntitySqlizer is a sqlizer generated for an entity, e.g. returned by ToSqlizer of a query builder.
+type EntitySqlizer interface {
+	sqr.Sqlizer
+
+	SqlizerUnwrapper
+}
+
+// Subquery makes sqlizer nestable into another query.
+// A select builder is rendered with question placeholders, since the outer query replaces them for the dialect.
+// Other sqlizers must render question placeholders by themselves.
+func Subquery(sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	return &subquerySqlizer{"", sqlizer}
+}
+
+// InQuery makes a condition whether column is in rows of the subquery.
+// column is quoted, and the subquery selects exactly one column.
+func InQuery(column string, sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	return &subquerySqlizer{column + " IN ", sqlizer}
+}
+
+// NotInQuery makes a condition whether column is not in rows of the subquery.
+func NotInQuery(column string, sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	return &subquerySqlizer{column + " NOT IN ", sqlizer}
+}
+
+// Exists makes a condition whether the subquery has any rows.
+func Exists(sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	return &subquerySqlizer{"EXISTS ", sqlizer}
+}
+
+// NotExists makes a condition whether the subquery has no rows.
+func NotExists(sqlizer sqr.Sqlizer) sqr.Sqlizer {
+	return &subquerySqlizer{"NOT EXISTS ", sqlizer}
+}
+
+type subquerySqlizer struct {
+	prefix string
+
+	sqlizer sqr.Sqlizer
+}
+
+func (s *subquerySqlizer) ToSql() (string, []interface{}, error) {
+	sqlizer := s.sqlizer
+	for {
+		unwrapper, ok := sqlizer.(SqlizerUnwrapper)
+		if !ok {
+			break
+		}
+		sqlizer = unwrapper.Unwrap()
+	}
+	if stmt, ok := sqlizer.(sqr.SelectBuilder); ok {
+		sqlizer = stmt.PlaceholderFormat(sqr.Question)
+	}
+	query, args, err := sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return s.prefix + "(" + query + ")", args, nil
+}
+
+// RelationSpec describes a relation from an entity table to another table, for relation predicates.
+type RelationSpec struct {
+	// entity table name, and its columns referred by the relation
+	Table string
+
+	ForeignKeys []string
+
+	// another table name, and its columns paired with ForeignKeys
+	RefeTable string
+
+	References []string
+
+	// join table name, and its columns paired with ForeignKeys and References, for many-to-many relation
+	Through string
+
+	ThroughForeignKeys []string
+
+	ThroughReferences []string
+}
+
+// HasRelation makes a correlated condition whether the entity has any related rows matching conds.
+// conds are evaluated in a derived table of another table, then its columns are referred by the table name or unqualified.
+func HasRelation(d dialect.Dialect, rel *RelationSpec, conds []sqr.Sqlizer) sqr.Sqlizer {
+	return Exists(relationQuery(d, rel, conds))
+}
+
+// HasNoRelation makes a correlated condition whether the entity has no related rows matching conds.
+func HasNoRelation(d dialect.Dialect, rel *RelationSpec, conds []sqr.Sqlizer) sqr.Sqlizer {
+	return NotExists(relationQuery(d, rel, conds))
+}
+
+func relationQuery(d dialect.Dialect, rel *RelationSpec, conds []sqr.Sqlizer) sqr.SelectBuilder {
+	// another table is aliased, then the entity table name refers the outer query even for self relations
+	table := d.Quote(rel.Table)
+	alias := d.Quote("goen_related")
+	var stmt sqr.SelectBuilder
+	if len(conds) > 0 {
+		// conds are isolated from the join table, placeholders are replaced by the outer query
+		related := sqr.Select("*").From(d.Quote(rel.RefeTable))
+		for _, cond := range conds {
+			related = related.Where(cond)
+		}
+		stmt = sqr.Select("1").FromSelect(related, alias)
+	} else {
+		stmt = sqr.Select("1").From(d.Quote(rel.RefeTable) + " AS " + alias)
+	}
+	if rel.Through != "" {
+		through := d.Quote("goen_through")
+		var on string
+		for i := range rel.References {
+			if i > 0 {
+				on += " AND "
+			}
+			on += through + "." + d.Quote(rel.ThroughReferences[i]) + " = " + alias + "." + d.Quote(rel.References[i])
+		}
+		stmt = stmt.Join(d.Quote(rel.Through) + " AS " + through + " ON " + on)
+		for i := range rel.ForeignKeys {
+			stmt = stmt.Where(through + "." + d.Quote(rel.ThroughForeignKeys[i]) + " = " + table + "." + d.Quote(rel.ForeignKeys[i]))
+		}
+	} else {
+		for i := range rel.ForeignKeys {
+			stmt = stmt.Where(alias + "." + d.Quote(rel.References[i]) + " = " + table + "." + d.Quote(rel.ForeignKeys[i]))
+		}
+	}
+	return stmt
+}
@@ -0,0 +1,105 @@
+package goen
+
+import (
+	"testing"
+
+	sqr "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+type testingWrappedSqlizer struct {
+	sqr.Sqlizer
+}
+
+func (s *testingWrappedSqlizer) Unwrap() sqr.Sqlizer {
+	return s.Sqlizer
+}
+
+func TestInQuery(t *testing.T) {
+	stmtBuilder := sqr.StatementBuilder.PlaceholderFormat(sqr.Dollar)
+	sub := stmtBuilder.Select(`"blog_id"`).From(`"blogs"`).Where(sqr.Eq{`"name"`: "golang"})
+
+	cases := []struct {
+		Cond  sqr.Sqlizer
+		Query string
+	}{
+		{
+			InQuery(`"blog_id"`, sub),
+			`SELECT "id" FROM "posts" WHERE "title" = $1 AND "blog_id" IN (SELECT "blog_id" FROM "blogs" WHERE "name" = $2)`,
+		},
+		{
+			NotInQuery(`"blog_id"`, &testingWrappedSqlizer{sub}),
+			`SELECT "id" FROM "posts" WHERE "title" = $1 AND "blog_id" NOT IN (SELECT "blog_id" FROM "blogs" WHERE "name" = $2)`,
+		},
+		{
+			Exists(sub),
+			`SELECT "id" FROM "posts" WHERE "title" = $1 AND EXISTS (SELECT "blog_id" FROM "blogs" WHERE "name" = $2)`,
+		},
+		{
+			NotExists(sqr.Expr(`SELECT 1 WHERE ? > 0`, 1)),
+			`SELECT "id" FROM "posts" WHERE "title" = $1 AND NOT EXISTS (SELECT 1 WHERE $2 > 0)`,
+		},
+	}
+	for _, c := range cases {
+		query, _, err := stmtBuilder.Select(`"id"`).From(`"posts"`).
+			Where(sqr.Eq{`"title"`: "hello"}).
+			Where(c.Cond).
+			ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.Query, query)
+		}
+	}
+}
+
+func TestHasRelation(t *testing.T) {
+	d := &testingCapabilitiesDialect{}
+	cases := []struct {
+		Cond  sqr.Sqlizer
+		Query string
+		Args  []interface{}
+	}{
+		{
+			HasRelation(d, &RelationSpec{
+				Table:       "blogs",
+				ForeignKeys: []string{"blog_id"},
+				RefeTable:   "posts",
+				References:  []string{"blog_id"},
+			}, []sqr.Sqlizer{sqr.Eq{`"title"`: "x"}}),
+			`EXISTS (SELECT 1 FROM (SELECT * FROM "posts" WHERE "title" = ?) AS "goen_related" WHERE "goen_related"."blog_id" = "blogs"."blog_id")`,
+			[]interface{}{"x"},
+		},
+		{
+			HasNoRelation(d, &RelationSpec{
+				Table:              "post",
+				ForeignKeys:        []string{"post_id"},
+				RefeTable:          "tag",
+				References:         []string{"id"},
+				Through:            "post_tags",
+				ThroughForeignKeys: []string{"post_id"},
+				ThroughReferences:  []string{"tag_id"},
+			}, nil),
+			`NOT EXISTS (SELECT 1 FROM "tag" AS "goen_related" JOIN "post_tags" AS "goen_through" ON "goen_through"."tag_id" = "goen_related"."id" WHERE "goen_through"."post_id" = "post"."post_id")`,
+			nil,
+		},
+		{
+			HasRelation(d, &RelationSpec{
+				Table:              "posts",
+				ForeignKeys:        []string{"post_id"},
+				RefeTable:          "tags",
+				References:         []string{"tag_id"},
+				Through:            "post_tags",
+				ThroughForeignKeys: []string{"post_id"},
+				ThroughReferences:  []string{"tag_id"},
+			}, []sqr.Sqlizer{sqr.Eq{`"tag_id"`: 1}}),
+			`EXISTS (SELECT 1 FROM (SELECT * FROM "tags" WHERE "tag_id" = ?) AS "goen_related" JOIN "post_tags" AS "goen_through" ON "goen_through"."tag_id" = "goen_related"."tag_id" WHERE "goen_through"."post_id" = "posts"."post_id")`,
+			[]interface{}{1},
+		},
+	}
+	for _, c := range cases {
+		query, args, err := c.Cond.ToSql()
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.Query, query)
+			assert.Equal(t, c.Args, args)
+		}
+	}
+}
@@ -11,6 +11,13 @@ type Parent struct {
 	GroupID uuid.UUID
 
 	Children []*Child `foreign_key:"parent_id,group_id"`
+
+	NumChildren int `aggregate:"count,Children"`
+
+	Tags []*Tag `through:"parent_tag" foreign_key:"parent_id" reference_key:"tag_id"`
+
+	// the foreign key is parent's primary key, so it's one-to-one
+	Profile *Profile `foreign_key:"parent_id"`
 }
 
 type Child struct {
@@ -22,3 +29,19 @@ type Child struct {
 
 	Parent *Parent `foreign_key:"parent_id,group_id"`
 }
+
+type Tag struct {
+	TagID uuid.UUID `goen:"" table:"tag" primary_key:""`
+
+	Name string
+}
+
+type Profile struct {
+	ProfileID uuid.UUID `goen:"" table:"profile" primary_key:""`
+
+	ParentID uuid.UUID
+
+	Nickname string
+
+	Parent *Parent `foreign_key:"parent_id"`
+}
@@ -20,7 +20,7 @@ func init() {
 }
 
 type ChildSqlizer interface {
-	squirrel.Sqlizer
+	goen.EntitySqlizer
 
 	ChildToSql() (string, []interface{}, error)
 }
@@ -33,6 +33,11 @@ func (sqlizer *_ChildSqlizer) ChildToSql() (string, []interface{}, error) {
 	return sqlizer.ToSql()
 }
 
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_ChildSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
 type ChildColumnExpr interface {
 	ChildColumnExpr() string
 
@@ -43,12 +48,119 @@ type ChildOrderExpr interface {
 	ChildOrderExpr() string
 }
 
+type ChildAssignment interface {
+	// ChildAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	ChildAssignment() (column string, value interface{})
+}
+
+type _ChildAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _ChildAssignment) ChildAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type ChildAggregateExpr interface {
+	// ChildAggregateExpr gets an aggregate function call and its result column name.
+	ChildAggregateExpr() (expr string, name string)
+}
+
+type _ChildAggregateExpr struct {
+	expr string
+	name string
+}
+
+// ChildAggregateExpr implements ChildAggregateExpr.
+func (e _ChildAggregateExpr) ChildAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _ChildAggregateExpr) As(name string) _ChildAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _ChildAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _ChildAggregateExpr) Eq(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) NotEq(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) Lt(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) LtOrEq(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) Gt(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) GtOrEq(v interface{}) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ChildAggregateExpr) Asc() ChildOrderExpr {
+	return _ChildAggregateOrderExpr(e.expr)
+}
+
+func (e _ChildAggregateExpr) Desc() ChildOrderExpr {
+	return _ChildAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _ChildAggregateOrderExpr string
+
+func (s _ChildAggregateOrderExpr) ChildOrderExpr() string {
+	return string(s)
+}
+
+// ChildPage is a page of keyset pagination.
+type ChildPage struct {
+	Records []*Child
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
 type ChildQueryBuilder struct {
 	dbc *goen.DBContext
 
 	includeLoaders goen.IncludeLoaderList
 
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
 	builder squirrel.SelectBuilder
+
+	Parent _Child_Join_Parent
 }
 
 func newChildQueryBuilder(dbc *goen.DBContext) ChildQueryBuilder {
@@ -67,26 +179,32 @@ func (qb ChildQueryBuilder) Include(loaders ...goen.IncludeLoader) ChildQueryBui
 }
 
 func (qb ChildQueryBuilder) Where(conds ...ChildSqlizer) ChildQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
 	for _, cond := range conds {
 		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
 	}
 	return qb
 }
 
 func (qb ChildQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) ChildQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
 	for _, cond := range conds {
 		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
 	}
 	return qb
 }
 
 func (qb ChildQueryBuilder) Offset(offset uint64) ChildQueryBuilder {
 	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
 	return qb
 }
 
 func (qb ChildQueryBuilder) Limit(limit uint64) ChildQueryBuilder {
 	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
 	return qb
 }
 
@@ -96,14 +214,231 @@ func (qb ChildQueryBuilder) OrderBy(orderBys ...ChildOrderExpr) ChildQueryBuilde
 		exprs[i] = orderBys[i].ChildOrderExpr()
 	}
 	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb ChildQueryBuilder) Columns(cols ...ChildColumnExpr) ChildQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb ChildQueryBuilder) ForUpdate() ChildQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
+
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb ChildQueryBuilder) ForShare() ChildQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ChildQueryBuilder) SkipLocked() ChildQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
+}
+
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ChildQueryBuilder) NoWait() ChildQueryBuilder {
+	qb.lock = qb.lock.NoWait()
+	return qb
+}
+
+// JoinParent joins parent as parent by the Parent relation, then qb.Parent refers its columns.
+// It's a left join, and the result set is still scanned into Child only.
+func (qb ChildQueryBuilder) JoinParent() ChildQueryBuilder {
+	if qb.Parent.joined {
+		return qb
+	}
+	dialect := qb.dbc.Dialect()
+	table := dialect.Quote(metaSchema.LoadOf(&Child{}).TableName())
+	alias := dialect.Quote("parent")
+	var on string
+	for i, pair := range [][2]string{
+		{"parent_id", "parent_id"},
+		{"group_id", "group_id"},
+	} {
+		if i > 0 {
+			on += " AND "
+		}
+		on += alias + "." + dialect.Quote(pair[0]) + " = " + table + "." + dialect.Quote(pair[1])
+	}
+	qb.builder = qb.builder.LeftJoin(dialect.Quote("parent") + " AS " + alias + " ON " + on)
+	qb.Parent = new_Child_Join_Parent(qb.dbc, alias)
+	qb.joined = true
+	return qb
+}
+
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb ChildQueryBuilder) GroupBy(cols ...ChildColumnExpr) ChildQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
+	return qb
+}
+
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb ChildQueryBuilder) Having(conds ...ChildSqlizer) ChildQueryBuilder {
+	for _, cond := range conds {
+		qb.builder = qb.builder.Having(cond)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
 	return qb
 }
 
+func (qb ChildQueryBuilder) ScanGroups(v interface{}, aggrs ...ChildAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
+}
+
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb ChildQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...ChildAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.ChildAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
+}
+
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb ChildQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb ChildQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...ChildOrderExpr) (*ChildPage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Child, and qb must not be ordered or limited by itself.
+func (qb ChildQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...ChildOrderExpr) (*ChildPage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
+	for i := range orderBys {
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Child{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &ChildPage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb ChildQueryBuilder) UpdateAll(sets ...ChildAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Child{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].ChildAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb ChildQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Child{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb ChildQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb ChildQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
 func (qb ChildQueryBuilder) Count() (int64, error) {
 	return qb.CountContext(context.Background())
 }
 
 func (qb ChildQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
 	query, args, err := qb.builder.Columns("count(*)").ToSql()
 	if err != nil {
 		return 0, err
@@ -141,14 +476,22 @@ func (qb ChildQueryBuilder) QueryRowContext(ctx context.Context) (*Child, error)
 }
 
 func (qb ChildQueryBuilder) query(ctx context.Context) ([]*Child, error) {
-	// for caching reason, wont support filtering columns
-	metaT := metaSchema.LoadOf(&Child{})
-	cols := make([]string, len(metaT.Columns()))
-	for i := range metaT.Columns() {
-		cols[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
 	}
 
-	query, args, err := qb.builder.Columns(cols...).ToSql()
+	var lockTables []string
+	if qb.joined {
+		// only rows of child are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("child"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +507,15 @@ func (qb ChildQueryBuilder) query(ctx context.Context) ([]*Child, error) {
 	}
 	rows.Close()
 
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
 	sc := goen.NewScopeCache(metaSchema)
 	for _, record := range records {
 		sc.AddObject(record)
@@ -175,29 +527,80 @@ func (qb ChildQueryBuilder) query(ctx context.Context) ([]*Child, error) {
 	return records, nil
 }
 
+// columnNames gets column names to be selected in table order.
+func (qb ChildQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Child{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+		selected["parent_id"] = true
+		selected["group_id"] = true
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb ChildQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Child{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
 // ToSqlizer returns Sqlizer that built by ChildQueryBuilder with given columns.
 // The columns defaults to all columns of Child, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
 func (qb ChildQueryBuilder) ToSqlizer(columns ...string) ChildSqlizer {
 	if len(columns) == 0 {
 		metaT := metaSchema.LoadOf(&Child{})
 		columns = make([]string, len(metaT.Columns()))
 		for i := range metaT.Columns() {
-			columns[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
 		}
 	}
 	// only return, not to set qb.builder.
 	return &_ChildSqlizer{qb.builder.Columns(columns...)}
 }
 
-type _Child_ChildID_OrderExpr string
+type _Child_ChildID_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Child_ChildID_OrderExpr) ChildOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Child_ChildID_OrderExpr) CursorOrder() (string, bool) {
+	return "child_id", s.desc
 }
 
 type _Child_ChildID struct {
 	bs string
 	qs string
+	es string
 }
 
 // ChildColumnExpr implements _Child_ChildID_OrderExpr.
@@ -215,71 +618,102 @@ func (c _Child_ChildID) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Child_ChildID) expr() string {
+	return c.es
+}
+
 func (c _Child_ChildID) Eq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) NotEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) In(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_ChildID) NotIn(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_ChildID) Like(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_ChildID) NotLike(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_ChildID) Lt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) LtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) Gt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) GtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ChildID) Between(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Child_ChildID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Child_ChildID) InQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Child_ChildID) NotInQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Child_ChildID) Set(v github_com_satori_go_uuid.UUID) ChildAssignment {
+	return _ChildAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Child_ChildID) Asc() ChildOrderExpr {
-	return _Child_ChildID_OrderExpr(c.QuotedString())
+	return _Child_ChildID_OrderExpr{c.expr(), false}
 }
 
 func (c _Child_ChildID) Desc() ChildOrderExpr {
-	return _Child_ChildID_OrderExpr(c.QuotedString() + " DESC")
+	return _Child_ChildID_OrderExpr{c.expr(), true}
 }
 
-type _Child_ParentID_OrderExpr string
+type _Child_ParentID_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Child_ParentID_OrderExpr) ChildOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Child_ParentID_OrderExpr) CursorOrder() (string, bool) {
+	return "parent_id", s.desc
 }
 
 type _Child_ParentID struct {
 	bs string
 	qs string
+	es string
 }
 
 // ChildColumnExpr implements _Child_ParentID_OrderExpr.
@@ -297,71 +731,102 @@ func (c _Child_ParentID) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Child_ParentID) expr() string {
+	return c.es
+}
+
 func (c _Child_ParentID) Eq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) In(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_ParentID) Like(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_ParentID) Lt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) Gt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Child_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Child_ParentID) InQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Child_ParentID) NotInQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Child_ParentID) Set(v github_com_satori_go_uuid.UUID) ChildAssignment {
+	return _ChildAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Child_ParentID) Asc() ChildOrderExpr {
-	return _Child_ParentID_OrderExpr(c.QuotedString())
+	return _Child_ParentID_OrderExpr{c.expr(), false}
 }
 
 func (c _Child_ParentID) Desc() ChildOrderExpr {
-	return _Child_ParentID_OrderExpr(c.QuotedString() + " DESC")
+	return _Child_ParentID_OrderExpr{c.expr(), true}
 }
 
-type _Child_GroupID_OrderExpr string
+type _Child_GroupID_OrderExpr struct {
+	expr string
+	desc bool
+}
 
 func (s _Child_GroupID_OrderExpr) ChildOrderExpr() string {
-	return string(s)
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Child_GroupID_OrderExpr) CursorOrder() (string, bool) {
+	return "group_id", s.desc
 }
 
 type _Child_GroupID struct {
 	bs string
 	qs string
+	es string
 }
 
 // ChildColumnExpr implements _Child_GroupID_OrderExpr.
@@ -379,132 +844,390 @@ func (c _Child_GroupID) QuotedString() string {
 	return c.qs
 }
 
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Child_GroupID) expr() string {
+	return c.es
+}
+
 func (c _Child_GroupID) Eq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) NotEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) In(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Eq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_GroupID) NotIn(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
 func (c _Child_GroupID) Like(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_GroupID) NotLike(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
 func (c _Child_GroupID) Lt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Lt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) LtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) Gt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Gt{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) GtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+	return &_ChildSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
 func (c _Child_GroupID) Between(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
 func (c _Child_GroupID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
-	return &_ChildSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Child_GroupID) InQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Child_GroupID) NotInQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Child_GroupID) Set(v github_com_satori_go_uuid.UUID) ChildAssignment {
+	return _ChildAssignment{c.String(), goen.ConvertValue(v)}
 }
 
 func (c _Child_GroupID) Asc() ChildOrderExpr {
-	return _Child_GroupID_OrderExpr(c.QuotedString())
+	return _Child_GroupID_OrderExpr{c.expr(), false}
 }
 
 func (c _Child_GroupID) Desc() ChildOrderExpr {
-	return _Child_GroupID_OrderExpr(c.QuotedString() + " DESC")
+	return _Child_GroupID_OrderExpr{c.expr(), true}
 }
 
-type ChildDBSet struct {
-	dbc *goen.DBContext
-
-	ChildID  _Child_ChildID
-	ParentID _Child_ParentID
-	GroupID  _Child_GroupID
+type _Child_Join_Parent struct {
+	joined bool
 
-	IncludeParent goen.IncludeLoader
+	ParentID _Child_Join_Parent_ParentID
+	GroupID  _Child_Join_Parent_GroupID
 }
 
-func newChildDBSet(dbc *goen.DBContext) *ChildDBSet {
-	dbset := &ChildDBSet{
-		dbc: dbc,
+func new_Child_Join_Parent(dbc *goen.DBContext, alias string) _Child_Join_Parent {
+	return _Child_Join_Parent{
+		joined: true,
+		ParentID: _Child_Join_Parent_ParentID{
+			bs: "parent_id",
+			qs: alias + "." + dbc.Dialect().Quote("parent_id"),
+		},
+		GroupID: _Child_Join_Parent_GroupID{
+			bs: "group_id",
+			qs: alias + "." + dbc.Dialect().Quote("group_id"),
+		},
 	}
-	dbset.ChildID = _Child_ChildID{"child_id", dbc.Dialect().Quote("child_id")}
-	dbset.ParentID = _Child_ParentID{"parent_id", dbc.Dialect().Quote("parent_id")}
-	dbset.GroupID = _Child_GroupID{"group_id", dbc.Dialect().Quote("group_id")}
+}
 
-	dbset.IncludeParent = goen.IncludeLoaderFunc(dbset.includeParent)
+type _Child_Join_Parent_ParentID_OrderExpr string
 
-	return dbset
+func (s _Child_Join_Parent_ParentID_OrderExpr) ChildOrderExpr() string {
+	return string(s)
 }
 
-// String gets bare table name
-func (dbset *ChildDBSet) String() string {
-	return "child"
+type _Child_Join_Parent_ParentID struct {
+	bs string
+	qs string
 }
 
-// QuotedString gets quoted table name
-func (dbset *ChildDBSet) QuotedString() string {
-	return dbset.dbc.Dialect().Quote("child")
+// String gets bare column name.
+func (c _Child_Join_Parent_ParentID) String() string {
+	return c.bs
 }
 
-func (dbset *ChildDBSet) Insert(v *Child) {
-	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
+// QuotedString gets column name qualified by the join alias.
+func (c _Child_Join_Parent_ParentID) QuotedString() string {
+	return c.qs
 }
 
-func (dbset *ChildDBSet) Select() ChildQueryBuilder {
-	return newChildQueryBuilder(dbset.dbc)
+func (c _Child_Join_Parent_ParentID) expr() string {
+	return c.qs
 }
 
-func (dbset *ChildDBSet) Update(v *Child) {
-	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+func (c _Child_Join_Parent_ParentID) Eq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (dbset *ChildDBSet) Delete(v *Child) {
-	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
+func (c _Child_Join_Parent_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (dbset *ChildDBSet) includeParent(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
-	entities, ok := records.([]*Child)
-	if !ok {
-		return nil
-	}
+func (c _Child_Join_Parent_ParentID) In(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
 
-	parentRowKeyOf := func(v *Child) goen.RowKey {
-		return &goen.MapRowKey{
-			Table: "parent",
-			Key: map[string]interface{}{
-				"parent_id": v.ParentID,
-				"group_id":  v.GroupID,
-			},
-		}
-	}
+func (c _Child_Join_Parent_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
 
-	// filter cached entity
-	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
-	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
-	for _, entity := range entities {
-		key := parentRowKeyOf(entity)
+func (c _Child_Join_Parent_ParentID) Like(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Child_Join_Parent_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Child_Join_Parent_ParentID) Lt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_ParentID) Gt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Child_Join_Parent_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Child_Join_Parent_ParentID) InQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Child_Join_Parent_ParentID) NotInQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Child_Join_Parent_ParentID) Asc() ChildOrderExpr {
+	return _Child_Join_Parent_ParentID_OrderExpr(c.QuotedString())
+}
+
+func (c _Child_Join_Parent_ParentID) Desc() ChildOrderExpr {
+	return _Child_Join_Parent_ParentID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Child_Join_Parent_GroupID_OrderExpr string
+
+func (s _Child_Join_Parent_GroupID_OrderExpr) ChildOrderExpr() string {
+	return string(s)
+}
+
+type _Child_Join_Parent_GroupID struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Child_Join_Parent_GroupID) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Child_Join_Parent_GroupID) QuotedString() string {
+	return c.qs
+}
+
+func (c _Child_Join_Parent_GroupID) expr() string {
+	return c.qs
+}
+
+func (c _Child_Join_Parent_GroupID) Eq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) NotEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) In(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) NotIn(v ...github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) Like(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Child_Join_Parent_GroupID) NotLike(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Child_Join_Parent_GroupID) Lt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) LtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) Gt(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) GtOrEq(v github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Child_Join_Parent_GroupID) Between(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Child_Join_Parent_GroupID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ChildSqlizer {
+	return &_ChildSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Child_Join_Parent_GroupID) InQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Child_Join_Parent_GroupID) NotInQuery(q goen.EntitySqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Child_Join_Parent_GroupID) Asc() ChildOrderExpr {
+	return _Child_Join_Parent_GroupID_OrderExpr(c.QuotedString())
+}
+
+func (c _Child_Join_Parent_GroupID) Desc() ChildOrderExpr {
+	return _Child_Join_Parent_GroupID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type ChildDBSet struct {
+	dbc *goen.DBContext
+
+	ChildID  _Child_ChildID
+	ParentID _Child_ParentID
+	GroupID  _Child_GroupID
+
+	IncludeParent goen.IncludeLoader
+}
+
+func newChildDBSet(dbc *goen.DBContext) *ChildDBSet {
+	dbset := &ChildDBSet{
+		dbc: dbc,
+	}
+	table := dbc.Dialect().Quote("child")
+	dbset.ChildID = _Child_ChildID{"child_id", dbc.Dialect().Quote("child_id"), table + "." + dbc.Dialect().Quote("child_id")}
+	dbset.ParentID = _Child_ParentID{"parent_id", dbc.Dialect().Quote("parent_id"), table + "." + dbc.Dialect().Quote("parent_id")}
+	dbset.GroupID = _Child_GroupID{"group_id", dbc.Dialect().Quote("group_id"), table + "." + dbc.Dialect().Quote("group_id")}
+
+	dbset.IncludeParent = goen.IncludeFetcherFunc(dbset.fetchParent)
+
+	return dbset
+}
+
+// String gets bare table name
+func (dbset *ChildDBSet) String() string {
+	return "child"
+}
+
+// QuotedString gets quoted table name
+func (dbset *ChildDBSet) QuotedString() string {
+	return dbset.dbc.Dialect().Quote("child")
+}
+
+func (dbset *ChildDBSet) Insert(v *Child) {
+	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
+}
+
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *ChildDBSet) Upsert(v *Child, conflictCols ...ChildColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
+}
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *ChildDBSet) Or(conds ...ChildSqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.Or(dbset.sqlizers(conds)...)}
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *ChildDBSet) And(conds ...ChildSqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.And(dbset.sqlizers(conds)...)}
+}
+
+// Not makes a condition whether cond is false.
+func (dbset *ChildDBSet) Not(cond ChildSqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.Not(cond)}
+}
+
+func (dbset *ChildDBSet) sqlizers(conds []ChildSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *ChildDBSet) CountExpr() _ChildAggregateExpr {
+	return _ChildAggregateExpr{"COUNT(*)", "count"}
+}
+
+func (dbset *ChildDBSet) Select() ChildQueryBuilder {
+	return newChildQueryBuilder(dbset.dbc)
+}
+
+func (dbset *ChildDBSet) Update(v *Child) {
+	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+}
+
+func (dbset *ChildDBSet) Delete(v *Child) {
+	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
+}
+
+func (dbset *ChildDBSet) fetchParent(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Child)
+	if !ok {
+		return func() {}, nil
+	}
+
+	parentRowKeyOf := func(v *Child) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "parent",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+				"group_id":  v.GroupID,
+			},
+		}
+	}
+
+	// filter cached entity
+	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	for _, entity := range entities {
+		key := parentRowKeyOf(entity)
 		if sc.HasObject(goen.CardinalityManyToOne, key) {
 			cachedChildRowKeys = append(cachedChildRowKeys, key)
 		} else {
@@ -512,29 +1235,28 @@ func (dbset *ChildDBSet) includeParent(ctx context.Context, later *goen.IncludeB
 		}
 	}
 	if len(noCachedChildRowKeys) > 0 {
-		cond := squirrel.Or{}
-		for _, rowKey := range noCachedChildRowKeys {
-			cond = append(cond, rowKey)
-		}
+		var noCachedEntities []*Parent
 		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
-		query, args, err := stmtBuilder.Select(
-			dbset.dbc.Dialect().Quote("parent_id"),
-			dbset.dbc.Dialect().Quote("group_id"),
-		).From(dbset.dbc.Dialect().Quote("parent")).Where(cond).ToSql()
-		if err != nil {
-			return err
-		}
-		rows, err := dbset.dbc.QueryContext(ctx, query, args...)
-		if err != nil {
-			return err
-		}
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("parent_id"),
+				dbset.dbc.Dialect().Quote("group_id"),
+			).From(dbset.dbc.Dialect().Quote("parent")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
 
-		var noCachedEntities []*Parent
-		if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
 			rows.Close()
-			return err
 		}
-		rows.Close()
 
 		for _, entity := range noCachedEntities {
 			sc.AddObject(entity)
@@ -544,15 +1266,48 @@ func (dbset *ChildDBSet) includeParent(ctx context.Context, later *goen.IncludeB
 		later.AddRecords(noCachedEntities)
 	}
 
-	for _, entity := range entities {
-		parentRowKey := parentRowKeyOf(entity)
-		raw := sc.GetObject(goen.CardinalityManyToOne, parentRowKey)
-		if castover, ok := raw.(*Parent); ok {
-			entity.Parent = castover
+	return func() {
+		for _, entity := range entities {
+			parentRowKey := parentRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityManyToOne, parentRowKey)
+			if castover, ok := raw.(*Parent); ok {
+				entity.Parent = castover
+			}
 		}
+	}, nil
+}
+
+func (dbset *ChildDBSet) relationParent() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "child",
+		ForeignKeys: []string{
+			"parent_id",
+			"group_id",
+		},
+		RefeTable: "parent",
+		References: []string{
+			"parent_id",
+			"group_id",
+		},
 	}
+}
 
-	return nil
+// WhereHasParent makes a condition whether the entity has any Parent matching conds, by a correlated EXISTS subquery.
+func (dbset *ChildDBSet) WhereHasParent(conds ...ParentSqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationParent(), dbset.sqlizersParent(conds))}
+}
+
+// WhereHasNoParent makes a condition whether the entity has no Parent matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *ChildDBSet) WhereHasNoParent(conds ...ParentSqlizer) ChildSqlizer {
+	return &_ChildSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationParent(), dbset.sqlizersParent(conds))}
+}
+
+func (dbset *ChildDBSet) sqlizersParent(conds []ParentSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
 }
 
 func init() {
@@ -560,7 +1315,7 @@ func init() {
 }
 
 type ParentSqlizer interface {
-	squirrel.Sqlizer
+	goen.EntitySqlizer
 
 	ParentToSql() (string, []interface{}, error)
 }
@@ -573,6 +1328,11 @@ func (sqlizer *_ParentSqlizer) ParentToSql() (string, []interface{}, error) {
 	return sqlizer.ToSql()
 }
 
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_ParentSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
 type ParentColumnExpr interface {
 	ParentColumnExpr() string
 
@@ -583,67 +1343,3505 @@ type ParentOrderExpr interface {
 	ParentOrderExpr() string
 }
 
+type ParentAssignment interface {
+	// ParentAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	ParentAssignment() (column string, value interface{})
+}
+
+type _ParentAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _ParentAssignment) ParentAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type ParentAggregateExpr interface {
+	// ParentAggregateExpr gets an aggregate function call and its result column name.
+	ParentAggregateExpr() (expr string, name string)
+}
+
+type _ParentAggregateExpr struct {
+	expr string
+	name string
+}
+
+// ParentAggregateExpr implements ParentAggregateExpr.
+func (e _ParentAggregateExpr) ParentAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _ParentAggregateExpr) As(name string) _ParentAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _ParentAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _ParentAggregateExpr) Eq(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) NotEq(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) Lt(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) LtOrEq(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) Gt(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) GtOrEq(v interface{}) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ParentAggregateExpr) Asc() ParentOrderExpr {
+	return _ParentAggregateOrderExpr(e.expr)
+}
+
+func (e _ParentAggregateExpr) Desc() ParentOrderExpr {
+	return _ParentAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _ParentAggregateOrderExpr string
+
+func (s _ParentAggregateOrderExpr) ParentOrderExpr() string {
+	return string(s)
+}
+
+// ParentPage is a page of keyset pagination.
+type ParentPage struct {
+	Records []*Parent
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
 type ParentQueryBuilder struct {
 	dbc *goen.DBContext
 
-	includeLoaders goen.IncludeLoaderList
+	includeLoaders goen.IncludeLoaderList
+
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
+	builder squirrel.SelectBuilder
+
+	Profile _Parent_Join_Profile
+}
+
+func newParentQueryBuilder(dbc *goen.DBContext) ParentQueryBuilder {
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
+	metaT := metaSchema.LoadOf(&Parent{})
+	return ParentQueryBuilder{
+		dbc: dbc,
+		// columns provided later
+		builder: stmtBuilder.Select().From(dbc.Dialect().Quote(metaT.TableName())),
+	}
+}
+
+func (qb ParentQueryBuilder) Include(loaders ...goen.IncludeLoader) ParentQueryBuilder {
+	qb.includeLoaders.Append(loaders...)
+	return qb
+}
+
+func (qb ParentQueryBuilder) Where(conds ...ParentSqlizer) ParentQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb ParentQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) ParentQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb ParentQueryBuilder) Offset(offset uint64) ParentQueryBuilder {
+	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
+	return qb
+}
+
+func (qb ParentQueryBuilder) Limit(limit uint64) ParentQueryBuilder {
+	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
+	return qb
+}
+
+func (qb ParentQueryBuilder) OrderBy(orderBys ...ParentOrderExpr) ParentQueryBuilder {
+	exprs := make([]string, len(orderBys))
+	for i := range orderBys {
+		exprs[i] = orderBys[i].ParentOrderExpr()
+	}
+	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb ParentQueryBuilder) Columns(cols ...ParentColumnExpr) ParentQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb ParentQueryBuilder) ForUpdate() ParentQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
+
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb ParentQueryBuilder) ForShare() ParentQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ParentQueryBuilder) SkipLocked() ParentQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
+}
+
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ParentQueryBuilder) NoWait() ParentQueryBuilder {
+	qb.lock = qb.lock.NoWait()
+	return qb
+}
+
+// JoinProfile joins profile as profile by the Profile relation, then qb.Profile refers its columns.
+// It's a left join, and the result set is still scanned into Parent only.
+func (qb ParentQueryBuilder) JoinProfile() ParentQueryBuilder {
+	if qb.Profile.joined {
+		return qb
+	}
+	dialect := qb.dbc.Dialect()
+	table := dialect.Quote(metaSchema.LoadOf(&Parent{}).TableName())
+	alias := dialect.Quote("profile")
+	var on string
+	for i, pair := range [][2]string{
+		{"parent_id", "parent_id"},
+	} {
+		if i > 0 {
+			on += " AND "
+		}
+		on += alias + "." + dialect.Quote(pair[0]) + " = " + table + "." + dialect.Quote(pair[1])
+	}
+	qb.builder = qb.builder.LeftJoin(dialect.Quote("profile") + " AS " + alias + " ON " + on)
+	qb.Profile = new_Parent_Join_Profile(qb.dbc, alias)
+	qb.joined = true
+	return qb
+}
+
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb ParentQueryBuilder) GroupBy(cols ...ParentColumnExpr) ParentQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
+	return qb
+}
+
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb ParentQueryBuilder) Having(conds ...ParentSqlizer) ParentQueryBuilder {
+	for _, cond := range conds {
+		qb.builder = qb.builder.Having(cond)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
+	return qb
+}
+
+func (qb ParentQueryBuilder) ScanGroups(v interface{}, aggrs ...ParentAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
+}
+
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb ParentQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...ParentAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.ParentAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
+}
+
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb ParentQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb ParentQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...ParentOrderExpr) (*ParentPage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Parent, and qb must not be ordered or limited by itself.
+func (qb ParentQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...ParentOrderExpr) (*ParentPage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
+	for i := range orderBys {
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Parent{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &ParentPage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb ParentQueryBuilder) UpdateAll(sets ...ParentAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Parent{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].ParentAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb ParentQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Parent{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb ParentQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb ParentQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
+func (qb ParentQueryBuilder) Count() (int64, error) {
+	return qb.CountContext(context.Background())
+}
+
+func (qb ParentQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
+	query, args, err := qb.builder.Columns("count(*)").ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (qb ParentQueryBuilder) Query() ([]*Parent, error) {
+	return qb.QueryContext(context.Background())
+}
+
+func (qb ParentQueryBuilder) QueryContext(ctx context.Context) ([]*Parent, error) {
+	return qb.query(ctx)
+}
+
+func (qb ParentQueryBuilder) QueryRow() (*Parent, error) {
+	return qb.QueryRowContext(context.Background())
+}
+
+func (qb ParentQueryBuilder) QueryRowContext(ctx context.Context) (*Parent, error) {
+	qb.builder = qb.builder.Limit(1)
+	if records, err := qb.query(ctx); err != nil {
+		return nil, err
+	} else if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	} else {
+		return records[0], nil
+	}
+}
+
+func (qb ParentQueryBuilder) query(ctx context.Context) ([]*Parent, error) {
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
+	}
+
+	var lockTables []string
+	if qb.joined {
+		// only rows of parent are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("parent"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*Parent
+	if err := qb.dbc.Scan(rows, &records); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
+	sc := goen.NewScopeCache(metaSchema)
+	for _, record := range records {
+		sc.AddObject(record)
+	}
+	if err := qb.dbc.IncludeContext(ctx, records, sc, qb.includeLoaders); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// columnNames gets column names to be selected in table order.
+func (qb ParentQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Parent{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+		selected["parent_id"] = true
+		selected["group_id"] = true
+		selected["parent_id"] = true
+		selected["parent_id"] = true
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb ParentQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Parent{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
+// ToSqlizer returns Sqlizer that built by ParentQueryBuilder with given columns.
+// The columns defaults to all columns of Parent, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
+func (qb ParentQueryBuilder) ToSqlizer(columns ...string) ParentSqlizer {
+	if len(columns) == 0 {
+		metaT := metaSchema.LoadOf(&Parent{})
+		columns = make([]string, len(metaT.Columns()))
+		for i := range metaT.Columns() {
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
+		}
+	}
+	// only return, not to set qb.builder.
+	return &_ParentSqlizer{qb.builder.Columns(columns...)}
+}
+
+type _Parent_ParentID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Parent_ParentID_OrderExpr) ParentOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Parent_ParentID_OrderExpr) CursorOrder() (string, bool) {
+	return "parent_id", s.desc
+}
+
+type _Parent_ParentID struct {
+	bs string
+	qs string
+	es string
+}
+
+// ParentColumnExpr implements _Parent_ParentID_OrderExpr.
+func (c _Parent_ParentID) ParentColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Parent_ParentID) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Parent_ParentID) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Parent_ParentID) expr() string {
+	return c.es
+}
+
+func (c _Parent_ParentID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_ParentID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_ParentID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Parent_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Parent_ParentID) InQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Parent_ParentID) NotInQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Parent_ParentID) Set(v github_com_satori_go_uuid.UUID) ParentAssignment {
+	return _ParentAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Parent_ParentID) Asc() ParentOrderExpr {
+	return _Parent_ParentID_OrderExpr{c.expr(), false}
+}
+
+func (c _Parent_ParentID) Desc() ParentOrderExpr {
+	return _Parent_ParentID_OrderExpr{c.expr(), true}
+}
+
+type _Parent_GroupID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Parent_GroupID_OrderExpr) ParentOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Parent_GroupID_OrderExpr) CursorOrder() (string, bool) {
+	return "group_id", s.desc
+}
+
+type _Parent_GroupID struct {
+	bs string
+	qs string
+	es string
+}
+
+// ParentColumnExpr implements _Parent_GroupID_OrderExpr.
+func (c _Parent_GroupID) ParentColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Parent_GroupID) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Parent_GroupID) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Parent_GroupID) expr() string {
+	return c.es
+}
+
+func (c _Parent_GroupID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_GroupID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_GroupID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_GroupID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_GroupID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_GroupID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Parent_GroupID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Parent_GroupID) InQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Parent_GroupID) NotInQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Parent_GroupID) Set(v github_com_satori_go_uuid.UUID) ParentAssignment {
+	return _ParentAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Parent_GroupID) Asc() ParentOrderExpr {
+	return _Parent_GroupID_OrderExpr{c.expr(), false}
+}
+
+func (c _Parent_GroupID) Desc() ParentOrderExpr {
+	return _Parent_GroupID_OrderExpr{c.expr(), true}
+}
+
+type _Parent_Join_Profile struct {
+	joined bool
+
+	ProfileID _Parent_Join_Profile_ProfileID
+	ParentID  _Parent_Join_Profile_ParentID
+	Nickname  _Parent_Join_Profile_Nickname
+}
+
+func new_Parent_Join_Profile(dbc *goen.DBContext, alias string) _Parent_Join_Profile {
+	return _Parent_Join_Profile{
+		joined: true,
+		ProfileID: _Parent_Join_Profile_ProfileID{
+			bs: "profile_id",
+			qs: alias + "." + dbc.Dialect().Quote("profile_id"),
+		},
+		ParentID: _Parent_Join_Profile_ParentID{
+			bs: "parent_id",
+			qs: alias + "." + dbc.Dialect().Quote("parent_id"),
+		},
+		Nickname: _Parent_Join_Profile_Nickname{
+			bs: "nickname",
+			qs: alias + "." + dbc.Dialect().Quote("nickname"),
+		},
+	}
+}
+
+type _Parent_Join_Profile_ProfileID_OrderExpr string
+
+func (s _Parent_Join_Profile_ProfileID_OrderExpr) ParentOrderExpr() string {
+	return string(s)
+}
+
+type _Parent_Join_Profile_ProfileID struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Parent_Join_Profile_ProfileID) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Parent_Join_Profile_ProfileID) QuotedString() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_ProfileID) expr() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_ProfileID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_ProfileID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_ProfileID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ProfileID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Parent_Join_Profile_ProfileID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Parent_Join_Profile_ProfileID) InQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_ProfileID) NotInQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_ProfileID) Asc() ParentOrderExpr {
+	return _Parent_Join_Profile_ProfileID_OrderExpr(c.QuotedString())
+}
+
+func (c _Parent_Join_Profile_ProfileID) Desc() ParentOrderExpr {
+	return _Parent_Join_Profile_ProfileID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Parent_Join_Profile_ParentID_OrderExpr string
+
+func (s _Parent_Join_Profile_ParentID_OrderExpr) ParentOrderExpr() string {
+	return string(s)
+}
+
+type _Parent_Join_Profile_ParentID struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Parent_Join_Profile_ParentID) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Parent_Join_Profile_ParentID) QuotedString() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_ParentID) expr() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_ParentID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_ParentID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Parent_Join_Profile_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Parent_Join_Profile_ParentID) InQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_ParentID) NotInQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_ParentID) Asc() ParentOrderExpr {
+	return _Parent_Join_Profile_ParentID_OrderExpr(c.QuotedString())
+}
+
+func (c _Parent_Join_Profile_ParentID) Desc() ParentOrderExpr {
+	return _Parent_Join_Profile_ParentID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Parent_Join_Profile_Nickname_OrderExpr string
+
+func (s _Parent_Join_Profile_Nickname_OrderExpr) ParentOrderExpr() string {
+	return string(s)
+}
+
+type _Parent_Join_Profile_Nickname struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Parent_Join_Profile_Nickname) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Parent_Join_Profile_Nickname) QuotedString() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_Nickname) expr() string {
+	return c.qs
+}
+
+func (c _Parent_Join_Profile_Nickname) Eq(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) NotEq(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) In(v ...string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) NotIn(v ...string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) Like(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_Nickname) NotLike(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Parent_Join_Profile_Nickname) Lt(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) LtOrEq(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) Gt(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) GtOrEq(v string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Parent_Join_Profile_Nickname) Between(v1, v2 string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Parent_Join_Profile_Nickname) NotBetween(v1, v2 string) ParentSqlizer {
+	return &_ParentSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Parent_Join_Profile_Nickname) InQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_Nickname) NotInQuery(q goen.EntitySqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Parent_Join_Profile_Nickname) Asc() ParentOrderExpr {
+	return _Parent_Join_Profile_Nickname_OrderExpr(c.QuotedString())
+}
+
+func (c _Parent_Join_Profile_Nickname) Desc() ParentOrderExpr {
+	return _Parent_Join_Profile_Nickname_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type ParentDBSet struct {
+	dbc *goen.DBContext
+
+	ParentID _Parent_ParentID
+	GroupID  _Parent_GroupID
+
+	IncludeChildren goen.IncludeLoader
+
+	IncludeProfile goen.IncludeLoader
+
+	IncludeTags goen.IncludeLoader
+}
+
+func newParentDBSet(dbc *goen.DBContext) *ParentDBSet {
+	dbset := &ParentDBSet{
+		dbc: dbc,
+	}
+	table := dbc.Dialect().Quote("parent")
+	dbset.ParentID = _Parent_ParentID{"parent_id", dbc.Dialect().Quote("parent_id"), table + "." + dbc.Dialect().Quote("parent_id")}
+	dbset.GroupID = _Parent_GroupID{"group_id", dbc.Dialect().Quote("group_id"), table + "." + dbc.Dialect().Quote("group_id")}
+
+	dbset.IncludeChildren = goen.IncludeFetcherFunc(dbset.fetchChildren)
+
+	dbset.IncludeProfile = goen.IncludeFetcherFunc(dbset.fetchProfile)
+
+	dbset.IncludeTags = goen.IncludeFetcherFunc(dbset.fetchTags)
+
+	return dbset
+}
+
+// String gets bare table name
+func (dbset *ParentDBSet) String() string {
+	return "parent"
+}
+
+// QuotedString gets quoted table name
+func (dbset *ParentDBSet) QuotedString() string {
+	return dbset.dbc.Dialect().Quote("parent")
+}
+
+func (dbset *ParentDBSet) Insert(v *Parent) {
+	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
+}
+
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *ParentDBSet) Upsert(v *Parent, conflictCols ...ParentColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
+}
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *ParentDBSet) Or(conds ...ParentSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.Or(dbset.sqlizers(conds)...)}
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *ParentDBSet) And(conds ...ParentSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.And(dbset.sqlizers(conds)...)}
+}
+
+// Not makes a condition whether cond is false.
+func (dbset *ParentDBSet) Not(cond ParentSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.Not(cond)}
+}
+
+func (dbset *ParentDBSet) sqlizers(conds []ParentSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *ParentDBSet) CountExpr() _ParentAggregateExpr {
+	return _ParentAggregateExpr{"COUNT(*)", "count"}
+}
+
+func (dbset *ParentDBSet) Select() ParentQueryBuilder {
+	return newParentQueryBuilder(dbset.dbc)
+}
+
+func (dbset *ParentDBSet) Update(v *Parent) {
+	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+}
+
+func (dbset *ParentDBSet) Delete(v *Parent) {
+	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
+}
+
+func (dbset *ParentDBSet) fetchChildren(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Parent)
+	if !ok {
+		return func() {}, nil
+	}
+
+	childRowKeyOf := func(v *Parent) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "child",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+				"group_id":  v.GroupID,
+			},
+		}
+	}
+
+	// filter cached entity
+	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	for _, entity := range entities {
+		key := childRowKeyOf(entity)
+		if sc.HasObject(goen.CardinalityOneToMany, key) {
+			cachedChildRowKeys = append(cachedChildRowKeys, key)
+		} else {
+			noCachedChildRowKeys = append(noCachedChildRowKeys, key)
+		}
+	}
+	if len(noCachedChildRowKeys) > 0 {
+		var noCachedEntities []*Child
+		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("child_id"),
+				dbset.dbc.Dialect().Quote("parent_id"),
+				dbset.dbc.Dialect().Quote("group_id"),
+			).From(dbset.dbc.Dialect().Quote("child")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
+
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			rows.Close()
+		}
+
+		for _, entity := range noCachedEntities {
+			sc.AddObject(entity)
+		}
+
+		// for newly loaded entity, to be filled by includeLoader
+		later.AddRecords(noCachedEntities)
+	}
+
+	return func() {
+		for _, entity := range entities {
+			childRowKey := childRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityOneToMany, childRowKey)
+			if refes, ok := raw.([]interface{}); ok {
+				// replaces rather than appends, the same field may be included by several loaders
+				children := make([]*Child, len(refes))
+				for i, refe := range refes {
+					children[i] = refe.(*Child)
+				}
+				entity.Children = children
+			}
+		}
+	}, nil
+}
+
+// _Parent_Children_IncludeLoader loads Children filtered by given conditions.
+// Loaded collections are not shared with other loaders, since they may be partial.
+type _Parent_Children_IncludeLoader struct {
+	dbset *ParentDBSet
+
+	conds []ChildSqlizer
+
+	orderBys []ChildOrderExpr
+
+	limit uint64
+}
+
+// IncludeChildrenWhere returns an include loader for Children filtered by conds.
+func (dbset *ParentDBSet) IncludeChildrenWhere(conds ...ChildSqlizer) _Parent_Children_IncludeLoader {
+	return _Parent_Children_IncludeLoader{dbset: dbset, conds: conds}
+}
+
+func (l _Parent_Children_IncludeLoader) OrderBy(orderBys ...ChildOrderExpr) _Parent_Children_IncludeLoader {
+	l.orderBys = append(l.orderBys[:len(l.orderBys):len(l.orderBys)], orderBys...)
+	return l
+}
+
+// LimitPerParent limits loaded Children to n entities for each Parent.
+func (l _Parent_Children_IncludeLoader) LimitPerParent(n uint64) _Parent_Children_IncludeLoader {
+	l.limit = n
+	return l
+}
+
+func (l _Parent_Children_IncludeLoader) Load(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+	assign, err := l.Fetch(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
+}
+
+func (l _Parent_Children_IncludeLoader) Fetch(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Parent)
+	if !ok || len(entities) == 0 {
+		return func() {}, nil
+	}
+
+	childRowKeyOf := func(v *Parent) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "child",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+				"group_id":  v.GroupID,
+			},
+		}
+	}
+
+	parentRowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		parentRowKeys[i] = childRowKeyOf(entity)
+	}
+	orderBys := make([]string, len(l.orderBys))
+	for i := range l.orderBys {
+		orderBys[i] = l.orderBys[i].ChildOrderExpr()
+	}
+	// bind parameters other than parent row keys
+	params := 0
+	if l.limit > 0 {
+		params++
+	}
+	for _, c := range l.conds {
+		_, args, err := c.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		params += len(args)
+	}
+	var (
+		children []*Child
+		limited  bool
+	)
+	for _, parentCond := range l.dbset.dbc.RowKeyConditionsWithParams(parentRowKeys, params) {
+		cond := squirrel.And{parentCond}
+		for _, c := range l.conds {
+			cond = append(cond, c)
+		}
+		var builder squirrel.SelectBuilder
+		builder, limited = goen.SelectPerParent(
+			l.dbset.dbc.Dialect(),
+			"child",
+			[]string{
+				"child_id",
+				"parent_id",
+				"group_id",
+			},
+			cond,
+			orderBys,
+			[]string{
+				"parent_id",
+				"group_id",
+			},
+			l.limit)
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to children for each chunk
+		if err := l.dbset.dbc.Scan(rows, &children); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	// partial collections must not be visible through the shared sc
+	partial := goen.NewScopeCache(metaSchema)
+	for _, child := range children {
+		partial.AddObject(child)
+	}
+
+	// for newly loaded entity, to be filled by includeLoader
+	later.AddRecords(children)
+
+	return func() {
+		for _, entity := range entities {
+			raw := partial.GetObject(goen.CardinalityOneToMany, childRowKeyOf(entity))
+			if refes, ok := raw.([]interface{}); ok {
+				// replaces rather than appends, the same field may be included by several loaders
+				children := make([]*Child, 0, len(refes))
+				for i, refe := range refes {
+					if !limited && uint64(i) >= l.limit {
+						break
+					}
+					children = append(children, refe.(*Child))
+				}
+				entity.Children = children
+			}
+		}
+	}, nil
+}
+
+func (dbset *ParentDBSet) relationChildren() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "parent",
+		ForeignKeys: []string{
+			"parent_id",
+			"group_id",
+		},
+		RefeTable: "child",
+		References: []string{
+			"parent_id",
+			"group_id",
+		},
+	}
+}
+
+// WhereHasChildren makes a condition whether the entity has any Children matching conds, by a correlated EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasChildren(conds ...ChildSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationChildren(), dbset.sqlizersChildren(conds))}
+}
+
+// WhereHasNoChildren makes a condition whether the entity has no Children matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasNoChildren(conds ...ChildSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationChildren(), dbset.sqlizersChildren(conds))}
+}
+
+func (dbset *ParentDBSet) sqlizersChildren(conds []ChildSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+type _Parent_CountChildren_AggregateRow struct {
+	Key0  github_com_satori_go_uuid.UUID       `column:"parent_id"`
+	Key1  github_com_satori_go_uuid.UUID       `column:"group_id"`
+	Value _Parent_CountChildren_AggregateValue `column:"goen_aggregate"`
+}
+
+// _Parent_CountChildren_AggregateValue scans an aggregated value by goen.AggregateScanner, it's left zero for NULL.
+type _Parent_CountChildren_AggregateValue struct {
+	v int64
+}
+
+func (v *_Parent_CountChildren_AggregateValue) Scan(src interface{}) error {
+	return goen.AggregateScanner(&v.v).Scan(src)
+}
+
+// _Parent_CountChildren_AggregateLoader aggregates Children by count for each Parent in one grouped query.
+type _Parent_CountChildren_AggregateLoader struct {
+	dbset *ParentDBSet
+}
+
+func (dbset *ParentDBSet) CountChildren() _Parent_CountChildren_AggregateLoader {
+	return _Parent_CountChildren_AggregateLoader{dbset}
+}
+
+func (l _Parent_CountChildren_AggregateLoader) Load(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
+	assign, err := l.Fetch(ctx, later, sc, records)
+	if err != nil {
+		return err
+	}
+	assign()
+	return nil
+}
+
+func (l _Parent_CountChildren_AggregateLoader) Fetch(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Parent)
+	if !ok || len(entities) == 0 {
+		return func() {}, nil
+	}
+
+	values, err := l.query(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		for _, entity := range entities {
+			value := values[metaSchema.KeyStringFromRowKey(l.rowKeyOf(entity))]
+			entity.NumChildren = (int)(value)
+		}
+	}, nil
+}
+
+func (l _Parent_CountChildren_AggregateLoader) rowKeyOf(v *Parent) goen.RowKey {
+	return &goen.MapRowKey{
+		Table: "child",
+		Key: map[string]interface{}{
+			"parent_id": v.ParentID,
+			"group_id":  v.GroupID,
+		},
+	}
+}
+
+// query gets aggregated values keyed by key string of rowKeyOf.
+func (l _Parent_CountChildren_AggregateLoader) query(ctx context.Context, entities []*Parent) (map[string]int64, error) {
+	values := map[string]int64{}
+	if len(entities) == 0 {
+		return values, nil
+	}
+
+	rowKeys := make([]goen.RowKey, len(entities))
+	for i, entity := range entities {
+		rowKeys[i] = l.rowKeyOf(entity)
+	}
+	dialect := l.dbset.dbc.Dialect()
+	keyColumns := []string{
+		dialect.Quote("parent_id"),
+		dialect.Quote("group_id"),
+	}
+	expr := "count(*)"
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dialect.PlaceholderFormat())
+	var aggrRows []_Parent_CountChildren_AggregateRow
+	for _, cond := range l.dbset.dbc.RowKeyConditions(rowKeys) {
+		query, args, err := stmtBuilder.Select(keyColumns...).
+			Column(expr + " AS " + dialect.Quote("goen_aggregate")).
+			From(dialect.Quote("child")).
+			Where(cond).
+			GroupBy(keyColumns...).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := l.dbset.dbc.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// appends to aggrRows for each chunk
+		if err := l.dbset.dbc.Scan(rows, &aggrRows); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+	}
+
+	for _, row := range aggrRows {
+		rowKey := &goen.MapRowKey{
+			Table: "child",
+			Key: map[string]interface{}{
+				"parent_id": row.Key0,
+				"group_id":  row.Key1,
+			},
+		}
+		values[metaSchema.KeyStringFromRowKey(rowKey)] = row.Value.v
+	}
+	return values, nil
+}
+
+func (dbset *ParentDBSet) fetchProfile(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Parent)
+	if !ok {
+		return func() {}, nil
+	}
+
+	childRowKeyOf := func(v *Parent) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "profile",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+			},
+		}
+	}
+
+	// filter cached entity
+	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	for _, entity := range entities {
+		key := childRowKeyOf(entity)
+		if sc.HasObject(goen.CardinalityOneToOne, key) {
+			cachedChildRowKeys = append(cachedChildRowKeys, key)
+		} else {
+			noCachedChildRowKeys = append(noCachedChildRowKeys, key)
+		}
+	}
+	if len(noCachedChildRowKeys) > 0 {
+		var noCachedEntities []*Profile
+		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("profile_id"),
+				dbset.dbc.Dialect().Quote("parent_id"),
+				dbset.dbc.Dialect().Quote("nickname"),
+			).From(dbset.dbc.Dialect().Quote("profile")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
+
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			rows.Close()
+		}
+
+		for _, entity := range noCachedEntities {
+			sc.AddObject(entity)
+		}
+
+		// for newly loaded entity, to be filled by includeLoader
+		later.AddRecords(noCachedEntities)
+	}
+
+	return func() {
+		for _, entity := range entities {
+			childRowKey := childRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityOneToOne, childRowKey)
+			if castover, ok := raw.(*Profile); ok {
+				entity.Profile = castover
+			}
+		}
+	}, nil
+}
+
+func (dbset *ParentDBSet) relationProfile() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "parent",
+		ForeignKeys: []string{
+			"parent_id",
+		},
+		RefeTable: "profile",
+		References: []string{
+			"parent_id",
+		},
+	}
+}
+
+// WhereHasProfile makes a condition whether the entity has any Profile matching conds, by a correlated EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasProfile(conds ...ProfileSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationProfile(), dbset.sqlizersProfile(conds))}
+}
+
+// WhereHasNoProfile makes a condition whether the entity has no Profile matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasNoProfile(conds ...ProfileSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationProfile(), dbset.sqlizersProfile(conds))}
+}
+
+func (dbset *ParentDBSet) sqlizersProfile(conds []ProfileSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+func (dbset *ParentDBSet) fetchTags(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Parent)
+	if !ok {
+		return func() {}, nil
+	}
+
+	// a row of the join table
+	type link struct {
+		Fore0 github_com_satori_go_uuid.UUID `column:"parent_id"`
+		Refe0 github_com_satori_go_uuid.UUID `column:"tag_id"`
+	}
+
+	linkRowKeyOf := func(v *Parent) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "parent_tag",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+			},
+		}
+	}
+
+	// filter cached links
+	noCachedLinkRowKeys := make([]goen.RowKey, 0, len(entities))
+	for _, entity := range entities {
+		key := linkRowKeyOf(entity)
+		if !sc.HasObject(goen.CardinalityManyToMany, key) {
+			noCachedLinkRowKeys = append(noCachedLinkRowKeys, key)
+		}
+	}
+	if len(noCachedLinkRowKeys) > 0 {
+		var links []*link
+		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedLinkRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("parent_id"),
+				dbset.dbc.Dialect().Quote("tag_id"),
+			).From(dbset.dbc.Dialect().Quote("parent_tag")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
+
+			// appends to links for each chunk
+			if err := dbset.dbc.Scan(rows, &links); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			rows.Close()
+		}
+
+		refeRowKeyOf := func(l *link) goen.RowKey {
+			return &goen.MapRowKey{
+				Table: "tag",
+				Key: map[string]interface{}{
+					"tag_id": l.Refe0,
+				},
+			}
+		}
+
+		// filter cached entity
+		noCachedRefeRowKeys := make([]goen.RowKey, 0, len(links))
+		for _, l := range links {
+			key := refeRowKeyOf(l)
+			if !sc.HasObject(goen.CardinalityNone, key) {
+				noCachedRefeRowKeys = append(noCachedRefeRowKeys, key)
+			}
+		}
+		if len(noCachedRefeRowKeys) > 0 {
+			var noCachedEntities []*Tag
+			for _, cond := range dbset.dbc.RowKeyConditions(noCachedRefeRowKeys) {
+				query, args, err := stmtBuilder.Select(
+					dbset.dbc.Dialect().Quote("tag_id"),
+					dbset.dbc.Dialect().Quote("name"),
+				).From(dbset.dbc.Dialect().Quote("tag")).Where(cond).ToSql()
+				if err != nil {
+					return nil, err
+				}
+				rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+				if err != nil {
+					return nil, err
+				}
+
+				// appends to noCachedEntities for each chunk
+				if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+					rows.Close()
+					return nil, err
+				}
+				rows.Close()
+			}
+
+			for _, entity := range noCachedEntities {
+				sc.AddObject(entity)
+			}
+
+			// for newly loaded entity, to be filled by includeLoader
+			later.AddRecords(noCachedEntities)
+		}
+
+		for _, key := range noCachedLinkRowKeys {
+			sc.AddLinkedObjects(key)
+		}
+		for _, l := range links {
+			linkRowKey := &goen.MapRowKey{
+				Table: "parent_tag",
+				Key: map[string]interface{}{
+					"parent_id": l.Fore0,
+				},
+			}
+			if refe := sc.GetObject(goen.CardinalityNone, refeRowKeyOf(l)); refe != nil {
+				sc.AddLinkedObjects(linkRowKey, refe)
+			}
+		}
+	}
+
+	return func() {
+		for _, entity := range entities {
+			raw := sc.GetObject(goen.CardinalityManyToMany, linkRowKeyOf(entity))
+			if refes, ok := raw.([]interface{}); ok {
+				// replaces rather than appends, the same field may be included by several loaders
+				children := make([]*Tag, len(refes))
+				for i, refe := range refes {
+					children[i] = refe.(*Tag)
+				}
+				entity.Tags = children
+			}
+		}
+	}, nil
+}
+
+// AddTags links v with refes by inserting rows into parent_tag.
+func (dbset *ParentDBSet) AddTags(v *Parent, refes ...*Tag) {
+	for _, refe := range refes {
+		dbset.dbc.Patch(goen.InsertPatch("parent_tag", []string{
+			"parent_id",
+			"tag_id",
+		}, []interface{}{
+			goen.ConvertValue(v.ParentID),
+			goen.ConvertValue(refe.TagID),
+		}))
+	}
+}
+
+// RemoveTags unlinks v with refes by deleting rows from parent_tag.
+func (dbset *ParentDBSet) RemoveTags(v *Parent, refes ...*Tag) {
+	for _, refe := range refes {
+		dbset.dbc.Patch(goen.DeletePatch("parent_tag", &goen.MapRowKey{
+			Table: "parent_tag",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+				"tag_id":    refe.TagID,
+			},
+		}))
+	}
+}
+
+func (dbset *ParentDBSet) relationTags() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "parent",
+		ForeignKeys: []string{
+			"parent_id",
+		},
+		RefeTable: "tag",
+		References: []string{
+			"tag_id",
+		},
+		Through: "parent_tag",
+		ThroughForeignKeys: []string{
+			"parent_id",
+		},
+		ThroughReferences: []string{
+			"tag_id",
+		},
+	}
+}
+
+// WhereHasTags makes a condition whether the entity has any Tags matching conds, by a correlated EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasTags(conds ...TagSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationTags(), dbset.sqlizersTags(conds))}
+}
+
+// WhereHasNoTags makes a condition whether the entity has no Tags matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *ParentDBSet) WhereHasNoTags(conds ...TagSqlizer) ParentSqlizer {
+	return &_ParentSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationTags(), dbset.sqlizersTags(conds))}
+}
+
+func (dbset *ParentDBSet) sqlizersTags(conds []TagSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+func init() {
+	metaSchema.Register(Profile{})
+}
+
+type ProfileSqlizer interface {
+	goen.EntitySqlizer
+
+	ProfileToSql() (string, []interface{}, error)
+}
+
+type _ProfileSqlizer struct {
+	squirrel.Sqlizer
+}
+
+func (sqlizer *_ProfileSqlizer) ProfileToSql() (string, []interface{}, error) {
+	return sqlizer.ToSql()
+}
+
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_ProfileSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
+type ProfileColumnExpr interface {
+	ProfileColumnExpr() string
+
+	String() string
+}
+
+type ProfileOrderExpr interface {
+	ProfileOrderExpr() string
+}
+
+type ProfileAssignment interface {
+	// ProfileAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	ProfileAssignment() (column string, value interface{})
+}
+
+type _ProfileAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _ProfileAssignment) ProfileAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type ProfileAggregateExpr interface {
+	// ProfileAggregateExpr gets an aggregate function call and its result column name.
+	ProfileAggregateExpr() (expr string, name string)
+}
+
+type _ProfileAggregateExpr struct {
+	expr string
+	name string
+}
+
+// ProfileAggregateExpr implements ProfileAggregateExpr.
+func (e _ProfileAggregateExpr) ProfileAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _ProfileAggregateExpr) As(name string) _ProfileAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _ProfileAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _ProfileAggregateExpr) Eq(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) NotEq(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) Lt(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) LtOrEq(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) Gt(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) GtOrEq(v interface{}) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _ProfileAggregateExpr) Asc() ProfileOrderExpr {
+	return _ProfileAggregateOrderExpr(e.expr)
+}
+
+func (e _ProfileAggregateExpr) Desc() ProfileOrderExpr {
+	return _ProfileAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _ProfileAggregateOrderExpr string
+
+func (s _ProfileAggregateOrderExpr) ProfileOrderExpr() string {
+	return string(s)
+}
+
+// ProfilePage is a page of keyset pagination.
+type ProfilePage struct {
+	Records []*Profile
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
+type ProfileQueryBuilder struct {
+	dbc *goen.DBContext
+
+	includeLoaders goen.IncludeLoaderList
+
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
+	builder squirrel.SelectBuilder
+
+	Parent _Profile_Join_Parent
+}
+
+func newProfileQueryBuilder(dbc *goen.DBContext) ProfileQueryBuilder {
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
+	metaT := metaSchema.LoadOf(&Profile{})
+	return ProfileQueryBuilder{
+		dbc: dbc,
+		// columns provided later
+		builder: stmtBuilder.Select().From(dbc.Dialect().Quote(metaT.TableName())),
+	}
+}
+
+func (qb ProfileQueryBuilder) Include(loaders ...goen.IncludeLoader) ProfileQueryBuilder {
+	qb.includeLoaders.Append(loaders...)
+	return qb
+}
+
+func (qb ProfileQueryBuilder) Where(conds ...ProfileSqlizer) ProfileQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb ProfileQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) ProfileQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb ProfileQueryBuilder) Offset(offset uint64) ProfileQueryBuilder {
+	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
+	return qb
+}
+
+func (qb ProfileQueryBuilder) Limit(limit uint64) ProfileQueryBuilder {
+	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
+	return qb
+}
+
+func (qb ProfileQueryBuilder) OrderBy(orderBys ...ProfileOrderExpr) ProfileQueryBuilder {
+	exprs := make([]string, len(orderBys))
+	for i := range orderBys {
+		exprs[i] = orderBys[i].ProfileOrderExpr()
+	}
+	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb ProfileQueryBuilder) Columns(cols ...ProfileColumnExpr) ProfileQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb ProfileQueryBuilder) ForUpdate() ProfileQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
+
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb ProfileQueryBuilder) ForShare() ProfileQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
+}
+
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ProfileQueryBuilder) SkipLocked() ProfileQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
+}
+
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb ProfileQueryBuilder) NoWait() ProfileQueryBuilder {
+	qb.lock = qb.lock.NoWait()
+	return qb
+}
+
+// JoinParent joins parent as parent by the Parent relation, then qb.Parent refers its columns.
+// It's a left join, and the result set is still scanned into Profile only.
+func (qb ProfileQueryBuilder) JoinParent() ProfileQueryBuilder {
+	if qb.Parent.joined {
+		return qb
+	}
+	dialect := qb.dbc.Dialect()
+	table := dialect.Quote(metaSchema.LoadOf(&Profile{}).TableName())
+	alias := dialect.Quote("parent")
+	var on string
+	for i, pair := range [][2]string{
+		{"parent_id", "parent_id"},
+	} {
+		if i > 0 {
+			on += " AND "
+		}
+		on += alias + "." + dialect.Quote(pair[0]) + " = " + table + "." + dialect.Quote(pair[1])
+	}
+	qb.builder = qb.builder.LeftJoin(dialect.Quote("parent") + " AS " + alias + " ON " + on)
+	qb.Parent = new_Profile_Join_Parent(qb.dbc, alias)
+	qb.joined = true
+	return qb
+}
+
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb ProfileQueryBuilder) GroupBy(cols ...ProfileColumnExpr) ProfileQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
+	return qb
+}
+
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb ProfileQueryBuilder) Having(conds ...ProfileSqlizer) ProfileQueryBuilder {
+	for _, cond := range conds {
+		qb.builder = qb.builder.Having(cond)
+	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
+	return qb
+}
+
+func (qb ProfileQueryBuilder) ScanGroups(v interface{}, aggrs ...ProfileAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
+}
+
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb ProfileQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...ProfileAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.ProfileAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
+}
+
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb ProfileQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb ProfileQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...ProfileOrderExpr) (*ProfilePage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Profile, and qb must not be ordered or limited by itself.
+func (qb ProfileQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...ProfileOrderExpr) (*ProfilePage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
+	for i := range orderBys {
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
+	}
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Profile{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &ProfilePage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb ProfileQueryBuilder) UpdateAll(sets ...ProfileAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Profile{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].ProfileAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb ProfileQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Profile{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb ProfileQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb ProfileQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
+func (qb ProfileQueryBuilder) Count() (int64, error) {
+	return qb.CountContext(context.Background())
+}
+
+func (qb ProfileQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
+	query, args, err := qb.builder.Columns("count(*)").ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	if err := row.Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (qb ProfileQueryBuilder) Query() ([]*Profile, error) {
+	return qb.QueryContext(context.Background())
+}
+
+func (qb ProfileQueryBuilder) QueryContext(ctx context.Context) ([]*Profile, error) {
+	return qb.query(ctx)
+}
+
+func (qb ProfileQueryBuilder) QueryRow() (*Profile, error) {
+	return qb.QueryRowContext(context.Background())
+}
+
+func (qb ProfileQueryBuilder) QueryRowContext(ctx context.Context) (*Profile, error) {
+	qb.builder = qb.builder.Limit(1)
+	if records, err := qb.query(ctx); err != nil {
+		return nil, err
+	} else if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	} else {
+		return records[0], nil
+	}
+}
+
+func (qb ProfileQueryBuilder) query(ctx context.Context) ([]*Profile, error) {
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
+	}
+
+	var lockTables []string
+	if qb.joined {
+		// only rows of profile are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("profile"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*Profile
+	if err := qb.dbc.Scan(rows, &records); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
+	sc := goen.NewScopeCache(metaSchema)
+	for _, record := range records {
+		sc.AddObject(record)
+	}
+	if err := qb.dbc.IncludeContext(ctx, records, sc, qb.includeLoaders); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// columnNames gets column names to be selected in table order.
+func (qb ProfileQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Profile{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+		selected["parent_id"] = true
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb ProfileQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Profile{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
+// ToSqlizer returns Sqlizer that built by ProfileQueryBuilder with given columns.
+// The columns defaults to all columns of Profile, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
+func (qb ProfileQueryBuilder) ToSqlizer(columns ...string) ProfileSqlizer {
+	if len(columns) == 0 {
+		metaT := metaSchema.LoadOf(&Profile{})
+		columns = make([]string, len(metaT.Columns()))
+		for i := range metaT.Columns() {
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
+		}
+	}
+	// only return, not to set qb.builder.
+	return &_ProfileSqlizer{qb.builder.Columns(columns...)}
+}
+
+type _Profile_ProfileID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Profile_ProfileID_OrderExpr) ProfileOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Profile_ProfileID_OrderExpr) CursorOrder() (string, bool) {
+	return "profile_id", s.desc
+}
+
+type _Profile_ProfileID struct {
+	bs string
+	qs string
+	es string
+}
+
+// ProfileColumnExpr implements _Profile_ProfileID_OrderExpr.
+func (c _Profile_ProfileID) ProfileColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Profile_ProfileID) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Profile_ProfileID) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Profile_ProfileID) expr() string {
+	return c.es
+}
+
+func (c _Profile_ProfileID) Eq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) NotEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) In(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_ProfileID) NotIn(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_ProfileID) Like(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_ProfileID) NotLike(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_ProfileID) Lt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) LtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) Gt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) GtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ProfileID) Between(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Profile_ProfileID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Profile_ProfileID) InQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Profile_ProfileID) NotInQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Profile_ProfileID) Set(v github_com_satori_go_uuid.UUID) ProfileAssignment {
+	return _ProfileAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Profile_ProfileID) Asc() ProfileOrderExpr {
+	return _Profile_ProfileID_OrderExpr{c.expr(), false}
+}
+
+func (c _Profile_ProfileID) Desc() ProfileOrderExpr {
+	return _Profile_ProfileID_OrderExpr{c.expr(), true}
+}
+
+type _Profile_ParentID_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Profile_ParentID_OrderExpr) ProfileOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Profile_ParentID_OrderExpr) CursorOrder() (string, bool) {
+	return "parent_id", s.desc
+}
+
+type _Profile_ParentID struct {
+	bs string
+	qs string
+	es string
+}
+
+// ProfileColumnExpr implements _Profile_ParentID_OrderExpr.
+func (c _Profile_ParentID) ProfileColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Profile_ParentID) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Profile_ParentID) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Profile_ParentID) expr() string {
+	return c.es
+}
+
+func (c _Profile_ParentID) Eq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) In(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_ParentID) Like(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_ParentID) Lt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) Gt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Profile_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Profile_ParentID) InQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Profile_ParentID) NotInQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Profile_ParentID) Set(v github_com_satori_go_uuid.UUID) ProfileAssignment {
+	return _ProfileAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Profile_ParentID) Asc() ProfileOrderExpr {
+	return _Profile_ParentID_OrderExpr{c.expr(), false}
+}
+
+func (c _Profile_ParentID) Desc() ProfileOrderExpr {
+	return _Profile_ParentID_OrderExpr{c.expr(), true}
+}
+
+type _Profile_Nickname_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Profile_Nickname_OrderExpr) ProfileOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Profile_Nickname_OrderExpr) CursorOrder() (string, bool) {
+	return "nickname", s.desc
+}
+
+type _Profile_Nickname struct {
+	bs string
+	qs string
+	es string
+}
+
+// ProfileColumnExpr implements _Profile_Nickname_OrderExpr.
+func (c _Profile_Nickname) ProfileColumnExpr() string {
+	return c.QuotedString()
+}
+
+// String gets bare column name.
+func (c _Profile_Nickname) String() string {
+	return c.bs
+}
+
+// QuotedString gets quoted column name.
+func (c _Profile_Nickname) QuotedString() string {
+	return c.qs
+}
+
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Profile_Nickname) expr() string {
+	return c.es
+}
+
+func (c _Profile_Nickname) Eq(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) NotEq(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) In(v ...string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Nickname) NotIn(v ...string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Nickname) Like(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Nickname) NotLike(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Nickname) Lt(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) LtOrEq(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) Gt(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) GtOrEq(v string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Nickname) Between(v1, v2 string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Profile_Nickname) NotBetween(v1, v2 string) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Profile_Nickname) InQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Profile_Nickname) NotInQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Profile_Nickname) Set(v string) ProfileAssignment {
+	return _ProfileAssignment{c.String(), goen.ConvertValue(v)}
+}
+
+func (c _Profile_Nickname) Asc() ProfileOrderExpr {
+	return _Profile_Nickname_OrderExpr{c.expr(), false}
+}
+
+func (c _Profile_Nickname) Desc() ProfileOrderExpr {
+	return _Profile_Nickname_OrderExpr{c.expr(), true}
+}
+
+type _Profile_Join_Parent struct {
+	joined bool
+
+	ParentID _Profile_Join_Parent_ParentID
+	GroupID  _Profile_Join_Parent_GroupID
+}
+
+func new_Profile_Join_Parent(dbc *goen.DBContext, alias string) _Profile_Join_Parent {
+	return _Profile_Join_Parent{
+		joined: true,
+		ParentID: _Profile_Join_Parent_ParentID{
+			bs: "parent_id",
+			qs: alias + "." + dbc.Dialect().Quote("parent_id"),
+		},
+		GroupID: _Profile_Join_Parent_GroupID{
+			bs: "group_id",
+			qs: alias + "." + dbc.Dialect().Quote("group_id"),
+		},
+	}
+}
+
+type _Profile_Join_Parent_ParentID_OrderExpr string
+
+func (s _Profile_Join_Parent_ParentID_OrderExpr) ProfileOrderExpr() string {
+	return string(s)
+}
+
+type _Profile_Join_Parent_ParentID struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Profile_Join_Parent_ParentID) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Profile_Join_Parent_ParentID) QuotedString() string {
+	return c.qs
+}
+
+func (c _Profile_Join_Parent_ParentID) expr() string {
+	return c.qs
+}
+
+func (c _Profile_Join_Parent_ParentID) Eq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) In(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) Like(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Join_Parent_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Join_Parent_ParentID) Lt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) Gt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Profile_Join_Parent_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Profile_Join_Parent_ParentID) InQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Profile_Join_Parent_ParentID) NotInQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Profile_Join_Parent_ParentID) Asc() ProfileOrderExpr {
+	return _Profile_Join_Parent_ParentID_OrderExpr(c.QuotedString())
+}
+
+func (c _Profile_Join_Parent_ParentID) Desc() ProfileOrderExpr {
+	return _Profile_Join_Parent_ParentID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type _Profile_Join_Parent_GroupID_OrderExpr string
+
+func (s _Profile_Join_Parent_GroupID_OrderExpr) ProfileOrderExpr() string {
+	return string(s)
+}
+
+type _Profile_Join_Parent_GroupID struct {
+	bs string
+	qs string
+}
+
+// String gets bare column name.
+func (c _Profile_Join_Parent_GroupID) String() string {
+	return c.bs
+}
+
+// QuotedString gets column name qualified by the join alias.
+func (c _Profile_Join_Parent_GroupID) QuotedString() string {
+	return c.qs
+}
+
+func (c _Profile_Join_Parent_GroupID) expr() string {
+	return c.qs
+}
+
+func (c _Profile_Join_Parent_GroupID) Eq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) NotEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) In(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) NotIn(v ...github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) Like(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Join_Parent_GroupID) NotLike(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
+}
+
+func (c _Profile_Join_Parent_GroupID) Lt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) LtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) Gt(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) GtOrEq(v github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
+}
+
+func (c _Profile_Join_Parent_GroupID) Between(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+func (c _Profile_Join_Parent_GroupID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ProfileSqlizer {
+	return &_ProfileSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
+}
+
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Profile_Join_Parent_GroupID) InQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.InQuery(c.expr(), q)}
+}
+
+func (c _Profile_Join_Parent_GroupID) NotInQuery(q goen.EntitySqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.NotInQuery(c.expr(), q)}
+}
+
+func (c _Profile_Join_Parent_GroupID) Asc() ProfileOrderExpr {
+	return _Profile_Join_Parent_GroupID_OrderExpr(c.QuotedString())
+}
+
+func (c _Profile_Join_Parent_GroupID) Desc() ProfileOrderExpr {
+	return _Profile_Join_Parent_GroupID_OrderExpr(c.QuotedString() + " DESC")
+}
+
+type ProfileDBSet struct {
+	dbc *goen.DBContext
+
+	ProfileID _Profile_ProfileID
+	ParentID  _Profile_ParentID
+	Nickname  _Profile_Nickname
+
+	IncludeParent goen.IncludeLoader
+}
+
+func newProfileDBSet(dbc *goen.DBContext) *ProfileDBSet {
+	dbset := &ProfileDBSet{
+		dbc: dbc,
+	}
+	table := dbc.Dialect().Quote("profile")
+	dbset.ProfileID = _Profile_ProfileID{"profile_id", dbc.Dialect().Quote("profile_id"), table + "." + dbc.Dialect().Quote("profile_id")}
+	dbset.ParentID = _Profile_ParentID{"parent_id", dbc.Dialect().Quote("parent_id"), table + "." + dbc.Dialect().Quote("parent_id")}
+	dbset.Nickname = _Profile_Nickname{"nickname", dbc.Dialect().Quote("nickname"), table + "." + dbc.Dialect().Quote("nickname")}
+
+	dbset.IncludeParent = goen.IncludeFetcherFunc(dbset.fetchParent)
+
+	return dbset
+}
+
+// String gets bare table name
+func (dbset *ProfileDBSet) String() string {
+	return "profile"
+}
+
+// QuotedString gets quoted table name
+func (dbset *ProfileDBSet) QuotedString() string {
+	return dbset.dbc.Dialect().Quote("profile")
+}
+
+func (dbset *ProfileDBSet) Insert(v *Profile) {
+	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
+}
+
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *ProfileDBSet) Upsert(v *Profile, conflictCols ...ProfileColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
+}
+
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *ProfileDBSet) Or(conds ...ProfileSqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.Or(dbset.sqlizers(conds)...)}
+}
+
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *ProfileDBSet) And(conds ...ProfileSqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.And(dbset.sqlizers(conds)...)}
+}
+
+// Not makes a condition whether cond is false.
+func (dbset *ProfileDBSet) Not(cond ProfileSqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.Not(cond)}
+}
+
+func (dbset *ProfileDBSet) sqlizers(conds []ProfileSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *ProfileDBSet) CountExpr() _ProfileAggregateExpr {
+	return _ProfileAggregateExpr{"COUNT(*)", "count"}
+}
+
+func (dbset *ProfileDBSet) Select() ProfileQueryBuilder {
+	return newProfileQueryBuilder(dbset.dbc)
+}
+
+func (dbset *ProfileDBSet) Update(v *Profile) {
+	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+}
+
+func (dbset *ProfileDBSet) Delete(v *Profile) {
+	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
+}
+
+func (dbset *ProfileDBSet) fetchParent(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) (func(), error) {
+	entities, ok := records.([]*Profile)
+	if !ok {
+		return func() {}, nil
+	}
+
+	parentRowKeyOf := func(v *Profile) goen.RowKey {
+		return &goen.MapRowKey{
+			Table: "parent",
+			Key: map[string]interface{}{
+				"parent_id": v.ParentID,
+			},
+		}
+	}
+
+	// filter cached entity
+	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
+	for _, entity := range entities {
+		key := parentRowKeyOf(entity)
+		if sc.HasObject(goen.CardinalityManyToOne, key) {
+			cachedChildRowKeys = append(cachedChildRowKeys, key)
+		} else {
+			noCachedChildRowKeys = append(noCachedChildRowKeys, key)
+		}
+	}
+	if len(noCachedChildRowKeys) > 0 {
+		var noCachedEntities []*Parent
+		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
+		for _, cond := range dbset.dbc.RowKeyConditions(noCachedChildRowKeys) {
+			query, args, err := stmtBuilder.Select(
+				dbset.dbc.Dialect().Quote("parent_id"),
+				dbset.dbc.Dialect().Quote("group_id"),
+			).From(dbset.dbc.Dialect().Quote("parent")).Where(cond).ToSql()
+			if err != nil {
+				return nil, err
+			}
+			rows, err := dbset.dbc.QueryContext(ctx, query, args...)
+			if err != nil {
+				return nil, err
+			}
+
+			// appends to noCachedEntities for each chunk
+			if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			rows.Close()
+		}
+
+		for _, entity := range noCachedEntities {
+			sc.AddObject(entity)
+		}
+
+		// for newly loaded entity, to be filled by includeLoader
+		later.AddRecords(noCachedEntities)
+	}
+
+	return func() {
+		for _, entity := range entities {
+			parentRowKey := parentRowKeyOf(entity)
+			raw := sc.GetObject(goen.CardinalityManyToOne, parentRowKey)
+			if castover, ok := raw.(*Parent); ok {
+				entity.Parent = castover
+			}
+		}
+	}, nil
+}
+
+func (dbset *ProfileDBSet) relationParent() *goen.RelationSpec {
+	return &goen.RelationSpec{
+		Table: "profile",
+		ForeignKeys: []string{
+			"parent_id",
+		},
+		RefeTable: "parent",
+		References: []string{
+			"parent_id",
+		},
+	}
+}
+
+// WhereHasParent makes a condition whether the entity has any Parent matching conds, by a correlated EXISTS subquery.
+func (dbset *ProfileDBSet) WhereHasParent(conds ...ParentSqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.HasRelation(dbset.dbc.Dialect(), dbset.relationParent(), dbset.sqlizersParent(conds))}
+}
+
+// WhereHasNoParent makes a condition whether the entity has no Parent matching conds, by a correlated NOT EXISTS subquery.
+func (dbset *ProfileDBSet) WhereHasNoParent(conds ...ParentSqlizer) ProfileSqlizer {
+	return &_ProfileSqlizer{goen.HasNoRelation(dbset.dbc.Dialect(), dbset.relationParent(), dbset.sqlizersParent(conds))}
+}
+
+func (dbset *ProfileDBSet) sqlizersParent(conds []ParentSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
+	}
+	return sqlizers
+}
+
+func init() {
+	metaSchema.Register(Tag{})
+}
+
+type TagSqlizer interface {
+	goen.EntitySqlizer
+
+	TagToSql() (string, []interface{}, error)
+}
+
+type _TagSqlizer struct {
+	squirrel.Sqlizer
+}
+
+func (sqlizer *_TagSqlizer) TagToSql() (string, []interface{}, error) {
+	return sqlizer.ToSql()
+}
+
+// Unwrap implements goen.SqlizerUnwrapper.
+func (sqlizer *_TagSqlizer) Unwrap() squirrel.Sqlizer {
+	return sqlizer.Sqlizer
+}
+
+type TagColumnExpr interface {
+	TagColumnExpr() string
+
+	String() string
+}
+
+type TagOrderExpr interface {
+	TagOrderExpr() string
+}
+
+type TagAssignment interface {
+	// TagAssignment gets a bare column name and its value, or squirrel.Sqlizer for an expression.
+	TagAssignment() (column string, value interface{})
+}
+
+type _TagAssignment struct {
+	column string
+	value  interface{}
+}
+
+func (a _TagAssignment) TagAssignment() (string, interface{}) {
+	return a.column, a.value
+}
+
+type TagAggregateExpr interface {
+	// TagAggregateExpr gets an aggregate function call and its result column name.
+	TagAggregateExpr() (expr string, name string)
+}
+
+type _TagAggregateExpr struct {
+	expr string
+	name string
+}
+
+// TagAggregateExpr implements TagAggregateExpr.
+func (e _TagAggregateExpr) TagAggregateExpr() (string, string) {
+	return e.expr, e.name
+}
+
+// As renames the result column, to be scanned into a struct field or a map key by the name.
+func (e _TagAggregateExpr) As(name string) _TagAggregateExpr {
+	e.name = name
+	return e
+}
+
+// String gets the result column name.
+func (e _TagAggregateExpr) String() string {
+	return e.name
+}
+
+func (e _TagAggregateExpr) Eq(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.Eq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) NotEq(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.NotEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) Lt(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.Lt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) LtOrEq(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.LtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) Gt(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.Gt{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) GtOrEq(v interface{}) TagSqlizer {
+	return &_TagSqlizer{squirrel.GtOrEq{e.expr: goen.ConvertValue(v)}}
+}
+
+func (e _TagAggregateExpr) Asc() TagOrderExpr {
+	return _TagAggregateOrderExpr(e.expr)
+}
+
+func (e _TagAggregateExpr) Desc() TagOrderExpr {
+	return _TagAggregateOrderExpr(e.expr + " DESC")
+}
+
+type _TagAggregateOrderExpr string
+
+func (s _TagAggregateOrderExpr) TagOrderExpr() string {
+	return string(s)
+}
+
+// TagPage is a page of keyset pagination.
+type TagPage struct {
+	Records []*Tag
+
+	// cursors of the next and previous pages, or empty if there are no more pages
+	Next goen.Cursor
+
+	Prev goen.Cursor
+}
+
+type TagQueryBuilder struct {
+	dbc *goen.DBContext
+
+	includeLoaders goen.IncludeLoaderList
+
+	// selected column names, or all columns if empty
+	columns []string
+
+	// whether any relation is joined, then columns are qualified by the table name
+	joined bool
+
+	// quoted columns grouped by
+	groupBy []string
+
+	// conditions given by Where, for UpdateAll and DeleteAll
+	wheres []squirrel.Sqlizer
+
+	// clauses given other than Where, which UpdateAll and DeleteAll are unable to apply
+	clauses []string
+
+	lock goen.RowLock
+
+	builder squirrel.SelectBuilder
+}
+
+func newTagQueryBuilder(dbc *goen.DBContext) TagQueryBuilder {
+	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
+	metaT := metaSchema.LoadOf(&Tag{})
+	return TagQueryBuilder{
+		dbc: dbc,
+		// columns provided later
+		builder: stmtBuilder.Select().From(dbc.Dialect().Quote(metaT.TableName())),
+	}
+}
+
+func (qb TagQueryBuilder) Include(loaders ...goen.IncludeLoader) TagQueryBuilder {
+	qb.includeLoaders.Append(loaders...)
+	return qb
+}
+
+func (qb TagQueryBuilder) Where(conds ...TagSqlizer) TagQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb TagQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) TagQueryBuilder {
+	qb.wheres = qb.wheres[:len(qb.wheres):len(qb.wheres)]
+	for _, cond := range conds {
+		qb.builder = qb.builder.Where(cond)
+		qb.wheres = append(qb.wheres, cond)
+	}
+	return qb
+}
+
+func (qb TagQueryBuilder) Offset(offset uint64) TagQueryBuilder {
+	qb.builder = qb.builder.Offset(offset)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Offset")
+	return qb
+}
+
+func (qb TagQueryBuilder) Limit(limit uint64) TagQueryBuilder {
+	qb.builder = qb.builder.Limit(limit)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Limit")
+	return qb
+}
+
+func (qb TagQueryBuilder) OrderBy(orderBys ...TagOrderExpr) TagQueryBuilder {
+	exprs := make([]string, len(orderBys))
+	for i := range orderBys {
+		exprs[i] = orderBys[i].TagOrderExpr()
+	}
+	qb.builder = qb.builder.OrderBy(exprs...)
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "OrderBy")
+	return qb
+}
+
+// Columns selects only given columns, and the rest are left zero.
+// The primary key and columns referred by relations are always selected.
+// Queried entities are marked as partial, then Update writes only the selected columns.
+func (qb TagQueryBuilder) Columns(cols ...TagColumnExpr) TagQueryBuilder {
+	names := make([]string, len(cols))
+	for i := range cols {
+		names[i] = cols[i].String()
+	}
+	qb.columns = append(qb.columns[:len(qb.columns):len(qb.columns)], names...)
+	return qb
+}
+
+// ForUpdate locks queried rows for update, it's only allowed in a transaction.
+// It's rejected by dialects without row-locking clauses; e.g. sqlite3.
+func (qb TagQueryBuilder) ForUpdate() TagQueryBuilder {
+	qb.lock = qb.lock.ForUpdate()
+	return qb
+}
 
-	builder squirrel.SelectBuilder
+// ForShare locks queried rows for share, it's only allowed in a transaction.
+func (qb TagQueryBuilder) ForShare() TagQueryBuilder {
+	qb.lock = qb.lock.ForShare()
+	return qb
 }
 
-func newParentQueryBuilder(dbc *goen.DBContext) ParentQueryBuilder {
-	stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbc.Dialect().PlaceholderFormat())
-	metaT := metaSchema.LoadOf(&Parent{})
-	return ParentQueryBuilder{
-		dbc: dbc,
-		// columns provided later
-		builder: stmtBuilder.Select().From(dbc.Dialect().Quote(metaT.TableName())),
-	}
+// SkipLocked skips already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb TagQueryBuilder) SkipLocked() TagQueryBuilder {
+	qb.lock = qb.lock.SkipLocked()
+	return qb
 }
 
-func (qb ParentQueryBuilder) Include(loaders ...goen.IncludeLoader) ParentQueryBuilder {
-	qb.includeLoaders.Append(loaders...)
+// NoWait fails without waiting for already locked rows, it implies ForUpdate unless ForShare is given.
+func (qb TagQueryBuilder) NoWait() TagQueryBuilder {
+	qb.lock = qb.lock.NoWait()
 	return qb
 }
 
-func (qb ParentQueryBuilder) Where(conds ...ParentSqlizer) ParentQueryBuilder {
-	for _, cond := range conds {
-		qb.builder = qb.builder.Where(cond)
+// GroupBy groups rows by cols, ScanGroups selects them with aggregates.
+func (qb TagQueryBuilder) GroupBy(cols ...TagColumnExpr) TagQueryBuilder {
+	for _, col := range cols {
+		name := qb.quoteColumn(col.String())
+		qb.groupBy = append(qb.groupBy[:len(qb.groupBy):len(qb.groupBy)], name)
+		qb.builder = qb.builder.GroupBy(name)
 	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "GroupBy")
 	return qb
 }
 
-func (qb ParentQueryBuilder) WhereRaw(conds ...squirrel.Sqlizer) ParentQueryBuilder {
+// Having filters groups by conds; e.g. dbset.CountExpr().Gt(1).
+func (qb TagQueryBuilder) Having(conds ...TagSqlizer) TagQueryBuilder {
 	for _, cond := range conds {
-		qb.builder = qb.builder.Where(cond)
+		qb.builder = qb.builder.Having(cond)
 	}
+	qb.clauses = append(qb.clauses[:len(qb.clauses):len(qb.clauses)], "Having")
 	return qb
 }
 
-func (qb ParentQueryBuilder) Offset(offset uint64) ParentQueryBuilder {
-	qb.builder = qb.builder.Offset(offset)
-	return qb
+func (qb TagQueryBuilder) ScanGroups(v interface{}, aggrs ...TagAggregateExpr) error {
+	return qb.ScanGroupsContext(context.Background(), v, aggrs...)
 }
 
-func (qb ParentQueryBuilder) Limit(limit uint64) ParentQueryBuilder {
-	qb.builder = qb.builder.Limit(limit)
-	return qb
+// ScanGroupsContext selects grouped columns and aggrs, then scans rows into v by DBContext.Scan.
+// v is a pointer to a slice of structs or maps; e.g. *[]map[string]interface{}.
+func (qb TagQueryBuilder) ScanGroupsContext(ctx context.Context, v interface{}, aggrs ...TagAggregateExpr) error {
+	if err := qb.lock.Reject("ScanGroups"); err != nil {
+		return err
+	}
+	cols := append([]string{}, qb.groupBy...)
+	for _, aggr := range aggrs {
+		expr, name := aggr.TagAggregateExpr()
+		cols = append(cols, expr+" AS "+qb.dbc.Dialect().Quote(name))
+	}
+	query, args, err := qb.builder.Columns(cols...).ToSql()
+	if err != nil {
+		return err
+	}
+	rows, err := qb.dbc.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	return qb.dbc.Scan(rows, v)
 }
 
-func (qb ParentQueryBuilder) OrderBy(orderBys ...ParentOrderExpr) ParentQueryBuilder {
-	exprs := make([]string, len(orderBys))
+// aggregate queries an aggregated value of expr, and scans it into dest.
+func (qb TagQueryBuilder) aggregate(ctx context.Context, expr string, dest interface{}) error {
+	if err := qb.lock.Reject("aggregates"); err != nil {
+		return err
+	}
+	query, args, err := qb.builder.Columns(expr).ToSql()
+	if err != nil {
+		return err
+	}
+	row := qb.dbc.QueryRowContext(ctx, query, args...)
+	return row.Scan(goen.AggregateScanner(dest))
+}
+
+func (qb TagQueryBuilder) Paginate(after goen.Cursor, pageSize uint64, orderBys ...TagOrderExpr) (*TagPage, error) {
+	return qb.PaginateContext(context.Background(), after, pageSize, orderBys...)
+}
+
+// PaginateContext queries a page after the cursor by keyset conditions of orderBys, the primary key is appended as a tie-breaker.
+// orderBys must be columns of Tag, and qb must not be ordered or limited by itself.
+func (qb TagQueryBuilder) PaginateContext(ctx context.Context, after goen.Cursor, pageSize uint64, orderBys ...TagOrderExpr) (*TagPage, error) {
+	orders := make([]goen.CursorOrder, len(orderBys))
 	for i := range orderBys {
-		exprs[i] = orderBys[i].ParentOrderExpr()
+		order, err := goen.CursorOrderOf(orderBys[i])
+		if err != nil {
+			return nil, err
+		}
+		orders[i] = order
 	}
-	qb.builder = qb.builder.OrderBy(exprs...)
-	return qb
+	p, err := qb.dbc.NewPagination(metaSchema.LoadOf(&Tag{}), orders, after, qb.quoteColumn)
+	if err != nil {
+		return nil, err
+	}
+	qb.builder, err = p.Apply(qb.builder, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	records, err := qb.query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &TagPage{}
+	page.Next, page.Prev, err = p.Page(&records, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page.Records = records
+	return page, nil
+}
+
+// UpdateAll updates all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error without sets, or with joins, orders, limits, groups or row-locking of the builder.
+func (qb TagQueryBuilder) UpdateAll(sets ...TagAssignment) *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Tag{})
+	var patch *goen.Patch
+	if len(sets) == 0 {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), goen.ErrNoAssignments)
+	} else if err := qb.bulkError("UpdateAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchUpdateAll, metaT.TableName(), err)
+	} else {
+		cols := make([]string, len(sets))
+		vals := make([]interface{}, len(sets))
+		for i := range sets {
+			cols[i], vals[i] = sets[i].TagAssignment()
+		}
+		patch = goen.UpdateAllPatch(metaT.TableName(), cols, vals, qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
+}
+
+// DeleteAll deletes all rows matching Where conditions by a statement, it's buffered until SaveChanges.
+// The result reports affected rows after SaveChanges.
+// SaveChanges reports an error with joins, orders, limits, groups or row-locking of the builder.
+func (qb TagQueryBuilder) DeleteAll() *goen.PatchResult {
+	metaT := metaSchema.LoadOf(&Tag{})
+	var patch *goen.Patch
+	if err := qb.bulkError("DeleteAll"); err != nil {
+		patch = goen.RejectedPatch(goen.PatchDeleteAll, metaT.TableName(), err)
+	} else {
+		patch = goen.DeleteAllPatch(metaT.TableName(), qb.where())
+	}
+	qb.dbc.Patch(patch)
+	return patch.Result
 }
 
-func (qb ParentQueryBuilder) Count() (int64, error) {
+// bulkError returns an error if op is unable to apply the builder; it's built only from Where conditions.
+func (qb TagQueryBuilder) bulkError(op string) error {
+	if qb.joined {
+		return goen.UnappliedClauseError(op, "joins")
+	}
+	if len(qb.clauses) > 0 {
+		return goen.UnappliedClauseError(op, qb.clauses[0])
+	}
+	return qb.lock.Reject(op)
+}
+
+func (qb TagQueryBuilder) where() squirrel.Sqlizer {
+	if len(qb.wheres) == 0 {
+		return nil
+	}
+	return squirrel.And(qb.wheres)
+}
+
+func (qb TagQueryBuilder) Count() (int64, error) {
 	return qb.CountContext(context.Background())
 }
 
-func (qb ParentQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+func (qb TagQueryBuilder) CountContext(ctx context.Context) (int64, error) {
+	if err := qb.lock.Reject("Count"); err != nil {
+		return 0, err
+	}
 	query, args, err := qb.builder.Columns("count(*)").ToSql()
 	if err != nil {
 		return 0, err
@@ -657,19 +4855,19 @@ func (qb ParentQueryBuilder) CountContext(ctx context.Context) (int64, error) {
 	return count, nil
 }
 
-func (qb ParentQueryBuilder) Query() ([]*Parent, error) {
+func (qb TagQueryBuilder) Query() ([]*Tag, error) {
 	return qb.QueryContext(context.Background())
 }
 
-func (qb ParentQueryBuilder) QueryContext(ctx context.Context) ([]*Parent, error) {
+func (qb TagQueryBuilder) QueryContext(ctx context.Context) ([]*Tag, error) {
 	return qb.query(ctx)
 }
 
-func (qb ParentQueryBuilder) QueryRow() (*Parent, error) {
+func (qb TagQueryBuilder) QueryRow() (*Tag, error) {
 	return qb.QueryRowContext(context.Background())
 }
 
-func (qb ParentQueryBuilder) QueryRowContext(ctx context.Context) (*Parent, error) {
+func (qb TagQueryBuilder) QueryRowContext(ctx context.Context) (*Tag, error) {
 	qb.builder = qb.builder.Limit(1)
 	if records, err := qb.query(ctx); err != nil {
 		return nil, err
@@ -680,15 +4878,23 @@ func (qb ParentQueryBuilder) QueryRowContext(ctx context.Context) (*Parent, erro
 	}
 }
 
-func (qb ParentQueryBuilder) query(ctx context.Context) ([]*Parent, error) {
-	// for caching reason, wont support filtering columns
-	metaT := metaSchema.LoadOf(&Parent{})
-	cols := make([]string, len(metaT.Columns()))
-	for i := range metaT.Columns() {
-		cols[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+func (qb TagQueryBuilder) query(ctx context.Context) ([]*Tag, error) {
+	names := qb.columnNames()
+	cols := make([]string, len(names))
+	for i := range names {
+		cols[i] = qb.quoteColumn(names[i])
 	}
 
-	query, args, err := qb.builder.Columns(cols...).ToSql()
+	var lockTables []string
+	if qb.joined {
+		// only rows of tag are locked, the nullable side of left joins can not be locked
+		lockTables = append(lockTables, qb.dbc.Dialect().Quote("tag"))
+	}
+	stmt, err := qb.lock.Apply(qb.dbc, qb.builder.Columns(cols...), lockTables...)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := stmt.ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -697,13 +4903,22 @@ func (qb ParentQueryBuilder) query(ctx context.Context) ([]*Parent, error) {
 		return nil, err
 	}
 
-	var records []*Parent
+	var records []*Tag
 	if err := qb.dbc.Scan(rows, &records); err != nil {
 		rows.Close()
 		return nil, err
 	}
 	rows.Close()
 
+	// Update writes only the loaded columns of partial rows
+	var loaded []string
+	if len(qb.columns) > 0 {
+		loaded = names
+	}
+	for _, record := range records {
+		qb.dbc.MarkPartial(metaSchema, record, loaded)
+	}
+
 	sc := goen.NewScopeCache(metaSchema)
 	for _, record := range records {
 		sc.AddObject(record)
@@ -715,303 +4930,362 @@ func (qb ParentQueryBuilder) query(ctx context.Context) ([]*Parent, error) {
 	return records, nil
 }
 
-// ToSqlizer returns Sqlizer that built by ParentQueryBuilder with given columns.
-// The columns defaults to all columns of Parent, if columns is zero-length.
-func (qb ParentQueryBuilder) ToSqlizer(columns ...string) ParentSqlizer {
+// columnNames gets column names to be selected in table order.
+func (qb TagQueryBuilder) columnNames() []string {
+	metaT := metaSchema.LoadOf(&Tag{})
+	selected := map[string]bool{}
+	for _, name := range qb.columns {
+		selected[name] = true
+	}
+	if len(qb.columns) > 0 {
+		// keys for the scope cache and include loaders
+		for _, metaC := range metaT.PrimaryKey() {
+			selected[metaC.ColumnName()] = true
+		}
+		for _, key := range metaT.ReferenceKeys() {
+			for _, metaC := range key {
+				selected[metaC.ColumnName()] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(metaT.Columns()))
+	for _, metaC := range metaT.Columns() {
+		if len(qb.columns) == 0 || selected[metaC.ColumnName()] {
+			names = append(names, metaC.ColumnName())
+		}
+	}
+	return names
+}
+
+// quoteColumn quotes a column name, and qualifies it by the table name when any relation is joined.
+func (qb TagQueryBuilder) quoteColumn(name string) string {
+	if !qb.joined {
+		return qb.dbc.Dialect().Quote(name)
+	}
+	metaT := metaSchema.LoadOf(&Tag{})
+	return qb.dbc.Dialect().Quote(metaT.TableName()) + "." + qb.dbc.Dialect().Quote(name)
+}
+
+// ToSqlizer returns Sqlizer that built by TagQueryBuilder with given columns.
+// The columns defaults to all columns of Tag, if columns is zero-length.
+// Row-locking is not applied, since it's intended to be a subquery.
+func (qb TagQueryBuilder) ToSqlizer(columns ...string) TagSqlizer {
 	if len(columns) == 0 {
-		metaT := metaSchema.LoadOf(&Parent{})
+		metaT := metaSchema.LoadOf(&Tag{})
 		columns = make([]string, len(metaT.Columns()))
 		for i := range metaT.Columns() {
-			columns[i] = qb.dbc.Dialect().Quote(metaT.Columns()[i].ColumnName())
+			columns[i] = qb.quoteColumn(metaT.Columns()[i].ColumnName())
 		}
 	}
 	// only return, not to set qb.builder.
-	return &_ParentSqlizer{qb.builder.Columns(columns...)}
+	return &_TagSqlizer{qb.builder.Columns(columns...)}
 }
 
-type _Parent_ParentID_OrderExpr string
+type _Tag_TagID_OrderExpr struct {
+	expr string
+	desc bool
+}
 
-func (s _Parent_ParentID_OrderExpr) ParentOrderExpr() string {
-	return string(s)
+func (s _Tag_TagID_OrderExpr) TagOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
 }
 
-type _Parent_ParentID struct {
+// CursorOrder implements goen.CursorOrder.
+func (s _Tag_TagID_OrderExpr) CursorOrder() (string, bool) {
+	return "tag_id", s.desc
+}
+
+type _Tag_TagID struct {
 	bs string
 	qs string
+	es string
 }
 
-// ParentColumnExpr implements _Parent_ParentID_OrderExpr.
-func (c _Parent_ParentID) ParentColumnExpr() string {
+// TagColumnExpr implements _Tag_TagID_OrderExpr.
+func (c _Tag_TagID) TagColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Parent_ParentID) String() string {
+func (c _Tag_TagID) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Parent_ParentID) QuotedString() string {
+func (c _Tag_TagID) QuotedString() string {
 	return c.qs
 }
 
-func (c _Parent_ParentID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Tag_TagID) expr() string {
+	return c.es
 }
 
-func (c _Parent_ParentID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Tag_TagID) Eq(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Tag_TagID) NotEq(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Tag_TagID) In(v ...github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Parent_ParentID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Tag_TagID) NotIn(v ...github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Parent_ParentID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Tag_TagID) Like(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Parent_ParentID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Tag_TagID) NotLike(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Parent_ParentID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Tag_TagID) Lt(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Tag_TagID) LtOrEq(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Tag_TagID) Gt(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Tag_TagID) GtOrEq(v github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_ParentID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Tag_TagID) Between(v1, v2 github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Parent_ParentID) Asc() ParentOrderExpr {
-	return _Parent_ParentID_OrderExpr(c.QuotedString())
+func (c _Tag_TagID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Parent_ParentID) Desc() ParentOrderExpr {
-	return _Parent_ParentID_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Tag_TagID) InQuery(q goen.EntitySqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-type _Parent_GroupID_OrderExpr string
+func (c _Tag_TagID) NotInQuery(q goen.EntitySqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.NotInQuery(c.expr(), q)}
+}
 
-func (s _Parent_GroupID_OrderExpr) ParentOrderExpr() string {
-	return string(s)
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Tag_TagID) Set(v github_com_satori_go_uuid.UUID) TagAssignment {
+	return _TagAssignment{c.String(), goen.ConvertValue(v)}
 }
 
-type _Parent_GroupID struct {
+func (c _Tag_TagID) Asc() TagOrderExpr {
+	return _Tag_TagID_OrderExpr{c.expr(), false}
+}
+
+func (c _Tag_TagID) Desc() TagOrderExpr {
+	return _Tag_TagID_OrderExpr{c.expr(), true}
+}
+
+type _Tag_Name_OrderExpr struct {
+	expr string
+	desc bool
+}
+
+func (s _Tag_Name_OrderExpr) TagOrderExpr() string {
+	if s.desc {
+		return s.expr + " DESC"
+	}
+	return s.expr
+}
+
+// CursorOrder implements goen.CursorOrder.
+func (s _Tag_Name_OrderExpr) CursorOrder() (string, bool) {
+	return "name", s.desc
+}
+
+type _Tag_Name struct {
 	bs string
 	qs string
+	es string
 }
 
-// ParentColumnExpr implements _Parent_GroupID_OrderExpr.
-func (c _Parent_GroupID) ParentColumnExpr() string {
+// TagColumnExpr implements _Tag_Name_OrderExpr.
+func (c _Tag_Name) TagColumnExpr() string {
 	return c.QuotedString()
 }
 
 // String gets bare column name.
-func (c _Parent_GroupID) String() string {
+func (c _Tag_Name) String() string {
 	return c.bs
 }
 
 // QuotedString gets quoted column name.
-func (c _Parent_GroupID) QuotedString() string {
+func (c _Tag_Name) QuotedString() string {
 	return c.qs
 }
 
-func (c _Parent_GroupID) Eq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Eq{c.QuotedString(): v}}
+// expr gets quoted column name qualified by the table name, to be unambiguous with joined tables.
+func (c _Tag_Name) expr() string {
+	return c.es
 }
 
-func (c _Parent_GroupID) NotEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Tag_Name) Eq(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Eq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) In(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Eq{c.QuotedString(): v}}
+func (c _Tag_Name) NotEq(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) NotIn(v ...github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.NotEq{c.QuotedString(): v}}
+func (c _Tag_Name) In(v ...string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Eq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Parent_GroupID) Like(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" LIKE ?", v)}
+func (c _Tag_Name) NotIn(v ...string) TagSqlizer {
+	return &_TagSqlizer{squirrel.NotEq{c.expr(): goen.ConvertValues(v)}}
 }
 
-func (c _Parent_GroupID) NotLike(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" NOT LIKE ?", v)}
+func (c _Tag_Name) Like(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Parent_GroupID) Lt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Lt{c.QuotedString(): v}}
+func (c _Tag_Name) NotLike(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" NOT LIKE ?", goen.ConvertValue(v))}
 }
 
-func (c _Parent_GroupID) LtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.LtOrEq{c.QuotedString(): v}}
+func (c _Tag_Name) Lt(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Lt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) Gt(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Gt{c.QuotedString(): v}}
+func (c _Tag_Name) LtOrEq(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.LtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) GtOrEq(v github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.GtOrEq{c.QuotedString(): v}}
+func (c _Tag_Name) Gt(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Gt{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) Between(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" BETWEEN ? AND ?", v1, v2)}
+func (c _Tag_Name) GtOrEq(v string) TagSqlizer {
+	return &_TagSqlizer{squirrel.GtOrEq{c.expr(): goen.ConvertValue(v)}}
 }
 
-func (c _Parent_GroupID) NotBetween(v1, v2 github_com_satori_go_uuid.UUID) ParentSqlizer {
-	return &_ParentSqlizer{squirrel.Expr(c.QuotedString()+" NOT BETWEEN ? AND ?", v1, v2)}
+func (c _Tag_Name) Between(v1, v2 string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Parent_GroupID) Asc() ParentOrderExpr {
-	return _Parent_GroupID_OrderExpr(c.QuotedString())
+func (c _Tag_Name) NotBetween(v1, v2 string) TagSqlizer {
+	return &_TagSqlizer{squirrel.Expr(c.expr()+" NOT BETWEEN ? AND ?", goen.ConvertValue(v1), goen.ConvertValue(v2))}
 }
 
-func (c _Parent_GroupID) Desc() ParentOrderExpr {
-	return _Parent_GroupID_OrderExpr(c.QuotedString() + " DESC")
+// InQuery makes a condition whether the column is in rows of q, q selects exactly one column; e.g. by ToSqlizer.
+func (c _Tag_Name) InQuery(q goen.EntitySqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.InQuery(c.expr(), q)}
 }
 
-type ParentDBSet struct {
-	dbc *goen.DBContext
+func (c _Tag_Name) NotInQuery(q goen.EntitySqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.NotInQuery(c.expr(), q)}
+}
 
-	ParentID _Parent_ParentID
-	GroupID  _Parent_GroupID
+// Set makes an assignment of v to the column, for UpdateAll.
+func (c _Tag_Name) Set(v string) TagAssignment {
+	return _TagAssignment{c.String(), goen.ConvertValue(v)}
+}
 
-	IncludeChildren goen.IncludeLoader
+func (c _Tag_Name) Asc() TagOrderExpr {
+	return _Tag_Name_OrderExpr{c.expr(), false}
 }
 
-func newParentDBSet(dbc *goen.DBContext) *ParentDBSet {
-	dbset := &ParentDBSet{
+func (c _Tag_Name) Desc() TagOrderExpr {
+	return _Tag_Name_OrderExpr{c.expr(), true}
+}
+
+type TagDBSet struct {
+	dbc *goen.DBContext
+
+	TagID _Tag_TagID
+	Name  _Tag_Name
+}
+
+func newTagDBSet(dbc *goen.DBContext) *TagDBSet {
+	dbset := &TagDBSet{
 		dbc: dbc,
 	}
-	dbset.ParentID = _Parent_ParentID{"parent_id", dbc.Dialect().Quote("parent_id")}
-	dbset.GroupID = _Parent_GroupID{"group_id", dbc.Dialect().Quote("group_id")}
-
-	dbset.IncludeChildren = goen.IncludeLoaderFunc(dbset.includeChildren)
+	table := dbc.Dialect().Quote("tag")
+	dbset.TagID = _Tag_TagID{"tag_id", dbc.Dialect().Quote("tag_id"), table + "." + dbc.Dialect().Quote("tag_id")}
+	dbset.Name = _Tag_Name{"name", dbc.Dialect().Quote("name"), table + "." + dbc.Dialect().Quote("name")}
 
 	return dbset
 }
 
 // String gets bare table name
-func (dbset *ParentDBSet) String() string {
-	return "parent"
+func (dbset *TagDBSet) String() string {
+	return "tag"
 }
 
 // QuotedString gets quoted table name
-func (dbset *ParentDBSet) QuotedString() string {
-	return dbset.dbc.Dialect().Quote("parent")
+func (dbset *TagDBSet) QuotedString() string {
+	return dbset.dbc.Dialect().Quote("tag")
 }
 
-func (dbset *ParentDBSet) Insert(v *Parent) {
+func (dbset *TagDBSet) Insert(v *Tag) {
 	dbset.dbc.Patch(metaSchema.InsertPatchOf(v))
 }
 
-func (dbset *ParentDBSet) Select() ParentQueryBuilder {
-	return newParentQueryBuilder(dbset.dbc)
+// Upsert inserts v, or updates the existing row conflicting by conflictCols or the primary key if empty.
+func (dbset *TagDBSet) Upsert(v *Tag, conflictCols ...TagColumnExpr) {
+	names := make([]string, len(conflictCols))
+	for i := range conflictCols {
+		names[i] = conflictCols[i].String()
+	}
+	dbset.dbc.Patch(metaSchema.UpsertPatchOf(v, names...))
 }
 
-func (dbset *ParentDBSet) Update(v *Parent) {
-	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+// Or makes a condition whether any of conds is true, it's false for no conds.
+func (dbset *TagDBSet) Or(conds ...TagSqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.Or(dbset.sqlizers(conds)...)}
 }
 
-func (dbset *ParentDBSet) Delete(v *Parent) {
-	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
+// And makes a condition whether all of conds are true, it's true for no conds.
+func (dbset *TagDBSet) And(conds ...TagSqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.And(dbset.sqlizers(conds)...)}
 }
 
-func (dbset *ParentDBSet) includeChildren(ctx context.Context, later *goen.IncludeBuffer, sc *goen.ScopeCache, records interface{}) error {
-	entities, ok := records.([]*Parent)
-	if !ok {
-		return nil
-	}
-
-	childRowKeyOf := func(v *Parent) goen.RowKey {
-		return &goen.MapRowKey{
-			Table: "child",
-			Key: map[string]interface{}{
-				"parent_id": v.ParentID,
-				"group_id":  v.GroupID,
-			},
-		}
-	}
+// Not makes a condition whether cond is false.
+func (dbset *TagDBSet) Not(cond TagSqlizer) TagSqlizer {
+	return &_TagSqlizer{goen.Not(cond)}
+}
 
-	// filter cached entity
-	cachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
-	noCachedChildRowKeys := make([]goen.RowKey, 0, len(entities))
-	for _, entity := range entities {
-		key := childRowKeyOf(entity)
-		if sc.HasObject(goen.CardinalityOneToMany, key) {
-			cachedChildRowKeys = append(cachedChildRowKeys, key)
-		} else {
-			noCachedChildRowKeys = append(noCachedChildRowKeys, key)
-		}
+func (dbset *TagDBSet) sqlizers(conds []TagSqlizer) []squirrel.Sqlizer {
+	sqlizers := make([]squirrel.Sqlizer, len(conds))
+	for i := range conds {
+		sqlizers[i] = conds[i]
 	}
-	if len(noCachedChildRowKeys) > 0 {
-		cond := squirrel.Or{}
-		for _, rowKey := range noCachedChildRowKeys {
-			cond = append(cond, rowKey)
-		}
-		stmtBuilder := squirrel.StatementBuilder.PlaceholderFormat(dbset.dbc.Dialect().PlaceholderFormat())
-		query, args, err := stmtBuilder.Select(
-			dbset.dbc.Dialect().Quote("child_id"),
-			dbset.dbc.Dialect().Quote("parent_id"),
-			dbset.dbc.Dialect().Quote("group_id"),
-		).From(dbset.dbc.Dialect().Quote("child")).Where(cond).ToSql()
-		if err != nil {
-			return err
-		}
-		rows, err := dbset.dbc.QueryContext(ctx, query, args...)
-		if err != nil {
-			return err
-		}
-
-		var noCachedEntities []*Child
-		if err := dbset.dbc.Scan(rows, &noCachedEntities); err != nil {
-			rows.Close()
-			return err
-		}
-		rows.Close()
+	return sqlizers
+}
 
-		for _, entity := range noCachedEntities {
-			sc.AddObject(entity)
-		}
+// CountExpr makes COUNT(*) for ScanGroups and Having, its result column name is count.
+func (dbset *TagDBSet) CountExpr() _TagAggregateExpr {
+	return _TagAggregateExpr{"COUNT(*)", "count"}
+}
 
-		// for newly loaded entity, to be filled by includeLoader
-		later.AddRecords(noCachedEntities)
-	}
+func (dbset *TagDBSet) Select() TagQueryBuilder {
+	return newTagQueryBuilder(dbset.dbc)
+}
 
-	for _, entity := range entities {
-		childRowKey := childRowKeyOf(entity)
-		raw := sc.GetObject(goen.CardinalityOneToMany, childRowKey)
-		if refes, ok := raw.([]interface{}); ok {
-			for _, refe := range refes {
-				entity.Children = append(entity.Children, refe.(*Child))
-			}
-		}
-	}
+func (dbset *TagDBSet) Update(v *Tag) {
+	dbset.dbc.Patch(metaSchema.UpdatePatchOf(v))
+}
 
-	return nil
+func (dbset *TagDBSet) Delete(v *Tag) {
+	dbset.dbc.Patch(metaSchema.DeletePatchOf(v))
 }
 
 type DBContext struct {
@@ -1020,6 +5294,10 @@ type DBContext struct {
 	Child *ChildDBSet
 
 	Parent *ParentDBSet
+
+	Profile *ProfileDBSet
+
+	Tag *TagDBSet
 }
 
 func NewDBContext(dialectName string, db *sql.DB) *DBContext {
@@ -1028,6 +5306,8 @@ func NewDBContext(dialectName string, db *sql.DB) *DBContext {
 		DBContext: dbc,
 		Child:     newChildDBSet(dbc),
 		Parent:    newParentDBSet(dbc),
+		Profile:   newProfileDBSet(dbc),
+		Tag:       newTagDBSet(dbc),
 	}
 }
 
@@ -1037,5 +5317,7 @@ func (dbc *DBContext) UseTx(tx *sql.Tx) *DBContext {
 		DBContext: clone,
 		Child:     newChildDBSet(clone),
 		Parent:    newParentDBSet(clone),
+		Profile:   newProfileDBSet(clone),
+		Tag:       newTagDBSet(clone),
 	}
 }
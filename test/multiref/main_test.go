@@ -3,6 +3,7 @@ package multiref_test
 import (
 	"testing"
 
+	"github.com/kamichidu/goen"
 	"github.com/kamichidu/goen/test/multiref"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -47,6 +48,15 @@ func TestMultiRef(t *testing.T) {
 			assert.Equal(t, children, parent.Children, "include loader loads an expected entity by multi column reference")
 		}
 	})
+	t.Run("aggregate relation", func(t *testing.T) {
+		loaded, err := dbc.Parent.Select().Include(
+			dbc.Parent.CountChildren(),
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2, loaded.NumChildren, "aggregate loader fills a count of related entities")
+			assert.Nil(t, loaded.Children, "aggregate loader does not load related entities")
+		}
+	})
 	t.Run("many to one relation", func(t *testing.T) {
 		child, err := dbc.Child.Select().Include(
 			dbc.Child.IncludeParent,
@@ -55,4 +65,157 @@ func TestMultiRef(t *testing.T) {
 			assert.Equal(t, parent, child.Parent, "include loader loads an expected entity by multi column reference")
 		}
 	})
+	t.Run("many to many relation", func(t *testing.T) {
+		tags := []*multiref.Tag{
+			&multiref.Tag{
+				TagID: uuid.Must(uuid.NewV4()),
+				Name:  "first",
+			},
+			&multiref.Tag{
+				TagID: uuid.Must(uuid.NewV4()),
+				Name:  "second",
+			},
+		}
+		for _, tag := range tags {
+			dbc.Tag.Insert(tag)
+		}
+		dbc.Parent.AddTags(parent, tags...)
+		if err := dbc.SaveChanges(); err != nil {
+			panic(err)
+		}
+
+		loaded, err := dbc.Parent.Select().Include(
+			dbc.Parent.IncludeTags,
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.ElementsMatch(t, tags, loaded.Tags, "include loader loads entities through the join table")
+		}
+
+		dbc.Parent.RemoveTags(parent, tags[0])
+		if err := dbc.SaveChanges(); err != nil {
+			panic(err)
+		}
+		loaded, err = dbc.Parent.Select().Include(
+			dbc.Parent.IncludeTags,
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []*multiref.Tag{tags[1]}, loaded.Tags, "removed link is not loaded")
+		}
+	})
+	t.Run("one to one relation", func(t *testing.T) {
+		profile := &multiref.Profile{
+			ProfileID: uuid.Must(uuid.NewV4()),
+			ParentID:  parent.ParentID,
+			Nickname:  "testing",
+		}
+		dbc.Profile.Insert(profile)
+		if err := dbc.SaveChanges(); err != nil {
+			panic(err)
+		}
+
+		loaded, err := dbc.Parent.Select().Include(
+			dbc.Parent.IncludeProfile,
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Equal(t, profile, loaded.Profile, "include loader loads an entity which has a foreign key to the parent")
+		}
+
+		loadedProfile, err := dbc.Profile.Select().Include(
+			dbc.Profile.IncludeParent,
+		).QueryRow()
+		if assert.NoError(t, err) && assert.NotNil(t, loadedProfile.Parent) {
+			assert.Equal(t, parent.ParentID, loadedProfile.Parent.ParentID, "include loader loads an entity which is referred by the foreign key")
+		}
+	})
+	t.Run("concurrent include", func(t *testing.T) {
+		dbc.IncludeConcurrency = 4
+		defer func() { dbc.IncludeConcurrency = 0 }()
+
+		loaded, err := dbc.Parent.Select().Include(
+			dbc.Parent.IncludeChildren,
+			dbc.Parent.IncludeTags,
+			dbc.Parent.IncludeProfile,
+			dbc.Parent.CountChildren(),
+			dbc.Child.IncludeParent,
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Len(t, loaded.Children, 2, "include loaders work concurrently")
+			assert.Len(t, loaded.Tags, 1, "include loaders work concurrently")
+			assert.NotNil(t, loaded.Profile, "include loaders work concurrently")
+			assert.Equal(t, 2, loaded.NumChildren, "include loaders work concurrently")
+			for _, child := range loaded.Children {
+				assert.True(t, loaded == child.Parent, "nested include loaders share the scope cache")
+			}
+		}
+	})
+	t.Run("concurrent include of the same field", func(t *testing.T) {
+		dbc.IncludeConcurrency = 4
+		defer func() { dbc.IncludeConcurrency = 0 }()
+
+		loaded, err := dbc.Parent.Select().Include(
+			dbc.Parent.IncludeChildren,
+			dbc.Parent.IncludeChildren,
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Len(t, loaded.Children, 2, "the same loader doesn't duplicate entities")
+		}
+		loaded, err = dbc.Parent.Select().Include(
+			dbc.Parent.IncludeChildren,
+			dbc.Parent.IncludeChildrenWhere().LimitPerParent(1),
+		).Where(dbc.Parent.ParentID.Eq(parent.ParentID)).QueryRow()
+		if assert.NoError(t, err) {
+			assert.Len(t, loaded.Children, 1, "the latter loader fills the field")
+		}
+	})
+	t.Run("chunked include", func(t *testing.T) {
+		dbc.IncludeChunkSize = 1
+		defer func() { dbc.IncludeChunkSize = 1000 }()
+
+		loaded, err := dbc.Child.Select().Include(
+			dbc.Child.IncludeParent,
+			dbc.Parent.IncludeChildren,
+		).Query()
+		if assert.NoError(t, err) && assert.Len(t, loaded, 2) {
+			assert.True(t, loaded[0].Parent != nil && loaded[0].Parent == loaded[1].Parent, "chunked include loader loads a parent once")
+			if loaded[0].Parent != nil {
+				assert.Len(t, loaded[0].Parent.Children, 2, "chunked include loader loads children")
+			}
+		}
+	})
+	t.Run("relation predicates", func(t *testing.T) {
+		count, err := dbc.Parent.Select().Where(dbc.Parent.WhereHasTags(dbc.Tag.Name.Eq("second"))).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(1), count, "exists subquery matches through the join table")
+		}
+		count, err = dbc.Parent.Select().Where(dbc.Parent.WhereHasTags(dbc.Tag.Name.Eq("first"))).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(0), count, "exists subquery does not match a removed link")
+		}
+		second, err := dbc.Tag.Select().Where(dbc.Tag.Name.Eq("second")).QueryRow()
+		if err != nil {
+			panic(err)
+		}
+		count, err = dbc.Parent.Select().Where(dbc.Parent.WhereHasTags(dbc.Tag.TagID.Eq(second.TagID))).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(1), count, "exists subquery filters by the column shared with the join table")
+		}
+		count, err = dbc.Parent.Select().Where(dbc.Parent.WhereHasNoChildren()).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(0), count, "not exists subquery correlates multi columns")
+		}
+		count, err = dbc.Child.Select().Where(dbc.Child.WhereHasParent(dbc.Parent.ParentID.Eq(parent.ParentID))).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(2), count, "exists subquery matches a referred entity")
+		}
+	})
+	t.Run("unapplied clauses of bulk statements", func(t *testing.T) {
+		dbc.Child.Select().Where(dbc.Child.ParentID.Eq(parent.ParentID)).Limit(1).DeleteAll()
+		assert.EqualError(t, dbc.SaveChanges(), "goen: DeleteAll is unable to apply Limit", "limit is not silently dropped")
+		dbc.Child.Select().Where(dbc.Child.ParentID.Eq(parent.ParentID)).UpdateAll()
+		assert.EqualError(t, dbc.SaveChanges(), goen.ErrNoAssignments.Error(), "update without assignments is rejected")
+		count, err := dbc.Child.Select().Where(dbc.Child.ParentID.Eq(parent.ParentID)).Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(2), count, "rejected statements are not executed")
+		}
+	})
 }
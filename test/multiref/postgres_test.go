@@ -23,6 +23,26 @@ create table child (
 	parent_id uuid not null,
 	group_id uuid not null
 );
+
+drop table if exists tag;
+create table tag (
+	tag_id uuid primary key,
+	name varchar not null
+);
+
+drop table if exists parent_tag;
+create table parent_tag (
+	parent_id uuid not null,
+	tag_id uuid not null,
+	primary key (parent_id, tag_id)
+);
+
+drop table if exists profile;
+create table profile (
+	profile_id uuid primary key,
+	parent_id uuid not null unique,
+	nickname varchar not null
+);
 `
 
 const dialectName = "postgres"